@@ -0,0 +1,125 @@
+package colorx
+
+import (
+	"image/color"
+	"math"
+
+	"github.com/somebadcode/go-colorx/v2/internal/mathx"
+)
+
+// Constants of the APCA-W3 0.0.98G-4g contrast algorithm.
+const (
+	apcaMainTRC     = 2.4
+	apcaNormBG      = 0.56
+	apcaNormTXT     = 0.57
+	apcaRevTXT      = 0.62
+	apcaRevBG       = 0.65
+	apcaBlackThresh = 0.022
+	apcaBlackClamp  = 1.414
+	apcaScale       = 1.14
+	apcaLowOffset   = 0.027
+	apcaLowClip     = 0.1
+	apcaDeltaYMin   = 0.0005
+)
+
+// APCABodyTextLc is the lowest absolute Lc that APCA recommends for body text.
+const APCABodyTextLc = 75.0
+
+// apcaFontSizes are the minimum font sizes in CSS pixels by absolute Lc in steps of 5, from Lc 0 to Lc 125, and by font
+// weight from 100 to 900. A size of 999 means that no text may use the contrast, 777 that it is only fit for non-text
+// elements.
+var apcaFontSizes = [...][9]float64{
+	{999, 999, 999, 999, 999, 999, 999, 999, 999},    // 0
+	{999, 999, 999, 999, 999, 999, 999, 999, 999},    // 5
+	{999, 999, 999, 999, 999, 999, 999, 999, 999},    // 10
+	{777, 777, 777, 777, 777, 777, 777, 777, 777},    // 15
+	{777, 777, 777, 777, 777, 777, 777, 777, 777},    // 20
+	{777, 777, 777, 120, 120, 108, 96, 96, 96},       // 25
+	{777, 777, 120, 108, 108, 96, 72, 72, 72},        // 30
+	{777, 120, 108, 96, 72, 60, 48, 48, 48},          // 35
+	{120, 108, 96, 60, 48, 42, 32, 32, 32},           // 40
+	{108, 96, 72, 42, 32, 28, 24, 24, 24},            // 45
+	{96, 72, 60, 32, 28, 24, 21, 21, 21},             // 50
+	{80, 60, 48, 28, 24, 21, 18, 18, 18},             // 55
+	{72, 48, 42, 24, 21, 18, 16, 16, 18},             // 60
+	{68, 46, 32, 21.75, 19, 17, 15, 16, 18},          // 65
+	{64, 44, 28, 19.5, 18, 16, 14.5, 16, 18},         // 70
+	{60, 42, 24, 18, 16, 15, 14, 16, 18},             // 75
+	{56, 38.25, 23, 17.25, 15.81, 14.81, 14, 16, 18}, // 80
+	{52, 34.5, 22, 16.5, 15.625, 14.625, 14, 16, 18}, // 85
+	{48, 32, 21, 16, 15.5, 14.5, 14, 16, 18},         // 90
+	{45, 28, 19.5, 15.5, 15, 14, 13.5, 16, 18},       // 95
+	{42, 26.5, 18.5, 15, 14.5, 13.5, 13, 16, 18},     // 100
+	{39, 25, 18, 14.5, 14, 13, 12, 16, 18},           // 105
+	{36, 24, 18, 14, 13, 12, 11, 16, 18},             // 110
+	{34, 22.5, 17.5, 13.5, 12.5, 11.5, 10, 16, 18},   // 115
+	{32, 21, 17, 13, 12, 11, 10, 16, 18},             // 120
+	{30, 20, 16.5, 12.5, 11.5, 10.5, 10, 16, 18},     // 125
+}
+
+// APCAContrast returns the APCA lightness contrast Lc of text on a background, as proposed for WCAG 3. Lc is positive
+// for dark text on a light background and negative for light text on a dark background, and its absolute value ranges
+// from 0 to about 108. Translucent text is composited over the background first. The background is treated as opaque.
+func APCAContrast(text, background color.Color) float64 {
+	txtR, txtG, txtB, bgR, bgG, bgB := composite(text, background)
+
+	yTxt := apcaLuminance(txtR, txtG, txtB)
+	yBg := apcaLuminance(bgR, bgG, bgB)
+
+	if math.Abs(yBg-yTxt) < apcaDeltaYMin {
+		return 0
+	}
+
+	if yBg > yTxt {
+		// Dark text on a light background.
+		sapc := (math.Pow(yBg, apcaNormBG) - math.Pow(yTxt, apcaNormTXT)) * apcaScale
+		if sapc < apcaLowClip {
+			return 0
+		}
+
+		return (sapc - apcaLowOffset) * 100
+	}
+
+	// Light text on a dark background.
+	sapc := (math.Pow(yBg, apcaRevBG) - math.Pow(yTxt, apcaRevTXT)) * apcaScale
+	if sapc > -apcaLowClip {
+		return 0
+	}
+
+	return (sapc + apcaLowOffset) * 100
+}
+
+// APCAMinFontSize returns the smallest font size in CSS pixels that APCA allows for text of the font weight at the
+// contrast Lc. The polarity of Lc is ignored and the weight is rounded to the nearest of 100, 200, …, 900. The size is
+// +Inf if the contrast is too low for any text.
+func APCAMinFontSize(lc float64, weight int) float64 {
+	row := int(math.Min(math.Abs(lc), 125) / 5)
+	col := int(mathx.Clamp(math.Round(float64(weight)/100), 1, 9)) - 1
+
+	size := apcaFontSizes[row][col]
+	if size >= 777 {
+		return math.Inf(1)
+	}
+
+	return size
+}
+
+// APCABodyText reports whether the contrast Lc is usable for body text of the font size in CSS pixels and font weight.
+// It requires an absolute Lc of at least APCABodyTextLc and a font size no smaller than APCAMinFontSize.
+func APCABodyText(lc, size float64, weight int) bool {
+	return math.Abs(lc) >= APCABodyTextLc && size >= APCAMinFontSize(lc, weight)
+}
+
+// apcaLuminance returns the estimated screen luminance of sRGB channels clipped to [0, 1], with soft clamping of
+// near-black colors.
+func apcaLuminance(r, g, b float64) float64 {
+	y := 0.2126729*math.Pow(mathx.Clamp(r, 0, 1), apcaMainTRC) +
+		0.7151522*math.Pow(mathx.Clamp(g, 0, 1), apcaMainTRC) +
+		0.0721750*math.Pow(mathx.Clamp(b, 0, 1), apcaMainTRC)
+
+	if y < apcaBlackThresh {
+		y += math.Pow(apcaBlackThresh-y, apcaBlackClamp)
+	}
+
+	return y
+}
@@ -0,0 +1,148 @@
+package colorx
+
+import (
+	"image/color"
+	"math"
+	"testing"
+
+	"github.com/somebadcode/go-colorx/v2/internal/mathx"
+)
+
+func TestAPCAContrast(t *testing.T) {
+	type args struct {
+		text       color.Color
+		background color.Color
+	}
+	tests := []struct {
+		name string
+		args args
+		want float64
+	}{
+		{
+			name: "black_on_white",
+			args: args{text: color.Black, background: color.White},
+			want: 106.04067,
+		},
+		{
+			name: "white_on_black",
+			args: args{text: color.White, background: color.Black},
+			want: -107.88473,
+		},
+		{
+			name: "gray_on_white",
+			args: args{text: CSS{R: 0x88, G: 0x88, B: 0x88, Opacity: 1.0}, background: color.White},
+			want: 63.05646,
+		},
+		{
+			name: "white_on_gray",
+			args: args{text: color.White, background: CSS{R: 0x88, G: 0x88, B: 0x88, Opacity: 1.0}},
+			want: -68.54146,
+		},
+		{
+			name: "same",
+			args: args{text: color.White, background: color.White},
+			want: 0.0,
+		},
+		{
+			name: "low_contrast",
+			args: args{text: CSS{R: 0xF0, G: 0xF0, B: 0xF0, Opacity: 1.0}, background: color.White},
+			want: 0.0,
+		},
+		{
+			name: "transparent",
+			args: args{text: color.Transparent, background: color.Black},
+			want: 0.0,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := APCAContrast(tt.args.text, tt.args.background); !mathx.EqualP(got, tt.want, 1e-3) {
+				t.Errorf("APCAContrast() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestAPCAMinFontSize(t *testing.T) {
+	type args struct {
+		lc     float64
+		weight int
+	}
+	tests := []struct {
+		name string
+		args args
+		want float64
+	}{
+		{
+			name: "body",
+			args: args{lc: 90.0, weight: 400},
+			want: 16.0,
+		},
+		{
+			name: "reverse_polarity",
+			args: args{lc: -77.3, weight: 700},
+			want: 14.0,
+		},
+		{
+			name: "weight_rounded",
+			args: args{lc: 60.0, weight: 380},
+			want: 24.0,
+		},
+		{
+			name: "beyond_table",
+			args: args{lc: 140.0, weight: 100},
+			want: 30.0,
+		},
+		{
+			name: "non_text",
+			args: args{lc: 20.0, weight: 900},
+			want: math.Inf(1),
+		},
+		{
+			name: "none",
+			args: args{lc: 5.0, weight: 400},
+			want: math.Inf(1),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := APCAMinFontSize(tt.args.lc, tt.args.weight); got != tt.want {
+				t.Errorf("APCAMinFontSize() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestAPCABodyText(t *testing.T) {
+	type args struct {
+		lc     float64
+		size   float64
+		weight int
+	}
+	tests := []struct {
+		name string
+		args args
+		want bool
+	}{
+		{
+			name: "black_on_white",
+			args: args{lc: 106.0, size: 16, weight: 400},
+			want: true,
+		},
+		{
+			name: "too_small",
+			args: args{lc: 80.0, size: 14, weight: 400},
+		},
+		{
+			name: "too_little_contrast",
+			args: args{lc: -63.0, size: 48, weight: 700},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := APCABodyText(tt.args.lc, tt.args.size, tt.args.weight); got != tt.want {
+				t.Errorf("APCABodyText() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -1,11 +1,10 @@
 package colorx
 
 import (
-	"encoding/hex"
-	"fmt"
 	"image/color"
 	"math"
-	"strconv"
+
+	"github.com/somebadcode/go-colorx/v2/internal/mathx"
 )
 
 // CSS an implementation of the color model used in Cascading Style Sheets.
@@ -23,23 +22,27 @@ func cssModel(c color.Color) color.Color {
 	if _, ok := c.(CSS); ok {
 		return c
 	}
-	r, g, b, a := c.RGBA()
-	return CSS{
-		R:       uint8(r >> 8),
-		G:       uint8(g >> 8),
-		B:       uint8(b >> 8),
-		Opacity: float64(a>>8) / math.MaxUint8,
-	}
+
+	// The channels of color.Color are alpha-premultiplied, CSS is not.
+	return cssFromFloat(toSRGB(c))
 }
 
 // RGBA returns the alpha-premultiplied red, green, blue and alpha values for the color.
 func (c CSS) RGBA() (r, g, b, a uint32) {
-	return color.RGBA{
-		R: c.R,
-		G: c.G,
-		B: c.B,
-		A: uint8(math.Min(c.SanitizedOpacity(), 1.0) * math.MaxUint8),
-	}.RGBA()
+	return premultiply(
+		float64(c.R)/math.MaxUint8,
+		float64(c.G)/math.MaxUint8,
+		float64(c.B)/math.MaxUint8,
+		c.SanitizedOpacity(),
+	)
+}
+
+func (c CSS) linear() (r, g, b, a float64) {
+	return linearizeRGB(c.srgb())
+}
+
+func (c CSS) srgb() (r, g, b, a float64) {
+	return float64(c.R) / math.MaxUint8, float64(c.G) / math.MaxUint8, float64(c.B) / math.MaxUint8, c.SanitizedOpacity()
 }
 
 // SanitizedOpacity returns the absolute value of opacity in the range [0.0, 1.0].
@@ -47,24 +50,28 @@ func (c CSS) SanitizedOpacity() float64 {
 	return math.Min(math.Abs(c.Opacity), 1.0)
 }
 
-// String returns the color in its CSS string format, either "rgb" or "rgba".
+// String returns the color in its CSS string format, either "rgb" or "rgba". The opacity is rounded half away from zero
+// to two decimals, so 0.125 is written as 0.13, but a translucent color is written with an opacity of at least 0.01
+// and at most 0.99. Use a Formatter for other formats.
 func (c CSS) String() string {
-	if c.SanitizedOpacity() < 1.0 {
-		return fmt.Sprintf("rgba(%d,%d,%d,%s)", c.R, c.G, c.B, slimFloatString(c.SanitizedOpacity(), 2))
-	}
-	return fmt.Sprintf("rgb(%d,%d,%d)", c.R, c.G, c.B)
+	return defaultFormatter.Format(c)
 }
 
-// HexString returns the color in the hexadecimal format used by CSS.
+// HexString returns the color in the lowercase hexadecimal format used by CSS. Use a Formatter for other formats.
 func (c CSS) HexString() string {
-	b := make([]byte, 0, 4)
-	b = append(b, c.R, c.G, c.B)
+	return defaultFormatter.Hex(c)
+}
 
-	if c.SanitizedOpacity() < 1.0 {
-		b = append(b, opacityUint8(c.SanitizedOpacity()))
-	}
+// AppendText appends the color in its CSS string format, like String, to dst and returns the extended buffer. It does
+// not allocate if dst has room for the text.
+func (c CSS) AppendText(dst []byte) []byte {
+	return defaultFormatter.AppendFormat(dst, c)
+}
 
-	return "#" + hex.EncodeToString(b)
+// AppendHex appends the color in the hexadecimal format, like HexString, to dst and returns the extended buffer. It
+// does not allocate if dst has room for the text.
+func (c CSS) AppendHex(dst []byte) []byte {
+	return defaultFormatter.AppendHex(dst, c)
 }
 
 // RGBAToCSS converts red, green, blue and alpha to red, green, blue and opacity.
@@ -72,11 +79,74 @@ func RGBAToCSS(r, g, b, a uint8) (uint8, uint8, uint8, float64) {
 	return r, g, b, math.Floor((float64(a)/float64(math.MaxUint8))*100.0) / 100.0
 }
 
-func slimFloatString(f float64, e int) string {
-	if f*math.Pow10(e) == math.Floor(f*math.Pow10(e)) {
-		return strconv.FormatFloat(f, 'f', -1, 64)
+// colorFunctionString returns the color in the CSS color() function, with the alpha left out if the color is opaque.
+func colorFunctionString(space Space, r, g, b, a float64) string {
+	return functionString("color("+space.String()+" ", r, g, b, a)
+}
+
+// functionString returns prefix followed by the components and alpha of a CSS color function in the modern syntax,
+// with the alpha left out if the color is opaque, as CSSOM serializes them.
+func functionString(prefix string, x, y, z, a float64) string {
+	return string(appendFunction(nil, prefix, x, y, z, a))
+}
+
+// appendFunction appends prefix followed by the components and alpha of a CSS color function in the modern syntax,
+// with the alpha left out if the color is opaque.
+func appendFunction(dst []byte, prefix string, x, y, z, a float64) []byte {
+	dst = append(dst, prefix...)
+	dst = appendNumber(dst, x, cssDecimals)
+	dst = append(dst, ' ')
+	dst = appendNumber(dst, y, cssDecimals)
+	dst = append(dst, ' ')
+	dst = appendNumber(dst, z, cssDecimals)
+
+	return appendAlpha(dst, a, cssDecimals)
+}
+
+// cssDecimals is the number of decimals that the String methods of the float color types round the components to.
+const cssDecimals = 4
+
+// appendHueFunction appends prefix followed by a hue, two fractions as percentages and the alpha of a CSS color
+// function in the modern syntax, like hsl() and hwb(), with the numbers rounded to the decimals.
+func appendHueFunction(dst []byte, prefix string, h, p1, p2, a float64, decimals int) []byte {
+	dst = append(dst, prefix...)
+	dst = appendNumber(dst, h, decimals)
+	dst = append(dst, ' ')
+	dst = appendPercent(dst, p1, decimals)
+	dst = append(dst, ' ')
+	dst = appendPercent(dst, p2, decimals)
+
+	return appendAlpha(dst, a, decimals)
+}
+
+// appendAlpha appends the alpha after a slash, unless the color is opaque, and the closing parenthesis.
+func appendAlpha(dst []byte, a float64, decimals int) []byte {
+	if math.IsNaN(a) {
+		dst = append(dst, " / none"...)
+	} else if a := mathx.Clamp(a, 0, 1); a < 1.0 {
+		dst = append(dst, " / "...)
+		dst = appendNumber(dst, a, decimals)
 	}
-	return strconv.FormatFloat(f, 'f', 2, 64)
+
+	return append(dst, ')')
+}
+
+// appendNumber appends the number with at most the decimals and without trailing zeros, or "none" if it is missing.
+func appendNumber(dst []byte, f float64, decimals int) []byte {
+	if math.IsNaN(f) {
+		return append(dst, "none"...)
+	}
+
+	return appendRounded(dst, f, decimals)
+}
+
+// appendPercent appends the fraction as a percentage with at most the decimals, or "none" if it is missing.
+func appendPercent(dst []byte, f float64, decimals int) []byte {
+	if math.IsNaN(f) {
+		return append(dst, "none"...)
+	}
+
+	return append(appendRounded(dst, f*100, decimals), '%')
 }
 
 func opacityUint8(f float64) uint8 {
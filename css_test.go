@@ -19,9 +19,30 @@ func TestCSSModel(t *testing.T) {
 		{
 			name: "rgba",
 			args: args{
-				c: color.RGBA{R: 0x80, G: 0x80},
+				c: color.RGBA{R: 0x80, G: 0x80, A: 0xFF},
 			},
-			want: CSS{R: 0x80, G: 0x80},
+			want: CSS{R: 0x80, G: 0x80, Opacity: 1.0},
+		},
+		{
+			name: "premultiplied",
+			args: args{
+				c: color.RGBA{R: 0x40, G: 0x40, A: 0x80},
+			},
+			want: CSS{R: 0x80, G: 0x80, Opacity: 0x8080 / float64(0xFFFF)},
+		},
+		{
+			name: "nrgba",
+			args: args{
+				c: color.NRGBA{R: 0xFF, A: 0x80},
+			},
+			want: CSS{R: 0xFF, Opacity: 0x8080 / float64(0xFFFF)},
+		},
+		{
+			name: "nrgba64",
+			args: args{
+				c: color.NRGBA64{R: 0xFFFF, A: 0x8000},
+			},
+			want: CSS{R: 0xFF, Opacity: 0x8000 / float64(0xFFFF)},
 		},
 		{
 			name: "css",
@@ -30,6 +51,13 @@ func TestCSSModel(t *testing.T) {
 			},
 			want: CSS{R: 0x80, G: 0x80, B: 0x80},
 		},
+		{
+			name: "rgba64",
+			args: args{
+				c: color.RGBA64{R: 0x8000, G: 0xFF00, B: 0x0080, A: 0xFFFF},
+			},
+			want: CSS{R: 0x80, G: 0xFE, B: 0x00, Opacity: 1.0},
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -174,7 +202,8 @@ func TestCSS_RGBA(t *testing.T) {
 	}{
 		{
 			name:   "black",
-			fields: fields{},
+			fields: fields{a: 1.0},
+			wantA:  0xFFFF,
 		},
 		{
 			name: "white",
@@ -182,58 +211,72 @@ func TestCSS_RGBA(t *testing.T) {
 				r: 0xFF,
 				g: 0xFF,
 				b: 0xFF,
+				a: 1.0,
 			},
 			wantR: 0xFFFF,
 			wantG: 0xFFFF,
 			wantB: 0xFFFF,
+			wantA: 0xFFFF,
 		},
 		{
 			name: "red",
 			fields: fields{
 				r: 0xFF,
+				a: 1.0,
 			},
 			wantR: 0xFFFF,
+			wantA: 0xFFFF,
 		},
 		{
 			name: "lime",
 			fields: fields{
 				g: 0xFF,
+				a: 1.0,
 			},
 			wantG: 0xFFFF,
+			wantA: 0xFFFF,
 		},
 		{
 			name: "blue",
 			fields: fields{
 				b: 0xFF,
+				a: 1.0,
 			},
 			wantB: 0xFFFF,
+			wantA: 0xFFFF,
 		},
 		{
 			name: "yellow",
 			fields: fields{
 				r: 0xFF,
 				g: 0xFF,
+				a: 1.0,
 			},
 			wantR: 0xFFFF,
 			wantG: 0xFFFF,
+			wantA: 0xFFFF,
 		},
 		{
 			name: "cyan",
 			fields: fields{
 				g: 0xFF,
 				b: 0xFF,
+				a: 1.0,
 			},
 			wantG: 0xFFFF,
 			wantB: 0xFFFF,
+			wantA: 0xFFFF,
 		},
 		{
 			name: "magenta",
 			fields: fields{
 				r: 0xFF,
 				b: 0xFF,
+				a: 1.0,
 			},
 			wantR: 0xFFFF,
 			wantB: 0xFFFF,
+			wantA: 0xFFFF,
 		},
 		{
 			name: "silver",
@@ -241,10 +284,12 @@ func TestCSS_RGBA(t *testing.T) {
 				r: 0xBF,
 				g: 0xBF,
 				b: 0xBF,
+				a: 1.0,
 			},
 			wantR: 0xBFBF,
 			wantG: 0xBFBF,
 			wantB: 0xBFBF,
+			wantA: 0xFFFF,
 		},
 		{
 			name: "gray",
@@ -252,58 +297,81 @@ func TestCSS_RGBA(t *testing.T) {
 				r: 0x7F,
 				g: 0x7F,
 				b: 0x7F,
+				a: 1.0,
 			},
 			wantR: 0x7F7F,
 			wantG: 0x7F7F,
 			wantB: 0x7F7F,
+			wantA: 0xFFFF,
 		},
 		{
 			name: "maroon",
 			fields: fields{
 				r: 0x7F,
+				a: 1.0,
 			},
 			wantR: 0x7F7F,
+			wantA: 0xFFFF,
 		},
 		{
 			name: "olive",
 			fields: fields{
 				r: 0x7F,
 				g: 0x7F,
+				a: 1.0,
 			},
 			wantR: 0x7F7F,
 			wantG: 0x7F7F,
+			wantA: 0xFFFF,
 		},
 		{
 			name: "green",
 			fields: fields{
 				g: 0x7F,
+				a: 1.0,
 			},
 			wantG: 0x7F7F,
+			wantA: 0xFFFF,
 		},
 		{
 			name: "purple",
 			fields: fields{
 				r: 0x7F,
 				b: 0x7F,
+				a: 1.0,
 			},
 			wantR: 0x7F7F,
 			wantB: 0x7F7F,
+			wantA: 0xFFFF,
 		},
 		{
 			name: "teal",
 			fields: fields{
 				g: 0x7F,
 				b: 0x7F,
+				a: 1.0,
 			},
 			wantG: 0x7F7F,
 			wantB: 0x7F7F,
+			wantA: 0xFFFF,
 		},
 		{
 			name: "navy",
 			fields: fields{
 				b: 0x7F,
+				a: 1.0,
 			},
 			wantB: 0x7F7F,
+			wantA: 0xFFFF,
+		},
+		{
+			name: "navy_alpha",
+			fields: fields{
+				b: 0xFF,
+				a: 0.5,
+			},
+			wantB: 0x8000,
+			wantA: 0x8000,
 		},
 	}
 	for _, tt := range tests {
@@ -433,6 +501,26 @@ func TestCSS_String(t *testing.T) {
 			fields: fields{b: 0x80, a: 0.543},
 			want:   "rgba(0,0,128,0.54)",
 		},
+		{
+			name:   "alpha_half_rounds_up",
+			fields: fields{b: 0x80, a: 0.125},
+			want:   "rgba(0,0,128,0.13)",
+		},
+		{
+			name:   "alpha_almost_transparent",
+			fields: fields{b: 0x80, a: 0.004},
+			want:   "rgba(0,0,128,0.01)",
+		},
+		{
+			name:   "alpha_almost_opaque",
+			fields: fields{b: 0x80, a: 0.999},
+			want:   "rgba(0,0,128,0.99)",
+		},
+		{
+			name:   "transparent",
+			fields: fields{b: 0x80},
+			want:   "rgba(0,0,128,0)",
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -508,3 +596,23 @@ func BenchmarkCSS_HexString(b *testing.B) {
 		_ = c.HexString()
 	}
 }
+
+func BenchmarkCSS_AppendText(b *testing.B) {
+	c := CSS{R: 0xFF, G: 0xBF, B: 0x80, Opacity: 0.5}
+	buf := make([]byte, 0, 64)
+
+	b.ReportAllocs()
+	for n := 0; n < b.N; n++ {
+		buf = c.AppendText(buf[:0])
+	}
+}
+
+func BenchmarkCSS_AppendHex(b *testing.B) {
+	c := CSS{R: 0xFF, G: 0xBF, B: 0x80, Opacity: 0.5}
+	buf := make([]byte, 0, 64)
+
+	b.ReportAllocs()
+	for n := 0; n < b.N; n++ {
+		buf = c.AppendHex(buf[:0])
+	}
+}
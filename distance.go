@@ -0,0 +1,169 @@
+package colorx
+
+import (
+	"image/color"
+	"math"
+)
+
+// CIE94Application selects the weighting factors used by DistanceCIE94.
+type CIE94Application int
+
+const (
+	CIE94GraphicArts CIE94Application = iota // Weights for graphic arts, kL = 1, K1 = 0.045, K2 = 0.015
+	CIE94Textiles                            // Weights for textiles, kL = 2, K1 = 0.048, K2 = 0.014
+)
+
+// DistanceCIE76 returns the CIE76 color difference ΔE*ab of two colors, the Euclidean distance between them in Lab
+// relative to D65. A difference of about 2.3 is just noticeable. Alpha is ignored.
+func DistanceCIE76(c1, c2 color.Color) float64 {
+	lab1, lab2 := convertLab(c1, D65), convertLab(c2, D65)
+
+	return math.Sqrt(sq(lab1.L-lab2.L) + sq(lab1.A-lab2.A) + sq(lab1.B-lab2.B))
+}
+
+// DistanceCIE94 returns the CIE94 color difference ΔE*94 of two colors using the weights of the application. CIE94 is
+// not symmetric, c1 is the reference color. An unknown application uses the weights of CIE94GraphicArts. Alpha is
+// ignored.
+func DistanceCIE94(c1, c2 color.Color, app CIE94Application) float64 {
+	var kL, k1, k2 float64
+
+	switch app {
+	case CIE94Textiles:
+		kL, k1, k2 = 2.0, 0.048, 0.014
+
+	default: // CIE94GraphicArts
+		kL, k1, k2 = 1.0, 0.045, 0.015
+	}
+
+	lab1, lab2 := convertLab(c1, D65), convertLab(c2, D65)
+
+	dL := lab1.L - lab2.L
+	chroma1 := math.Hypot(lab1.A, lab1.B)
+	dC := chroma1 - math.Hypot(lab2.A, lab2.B)
+	dH2 := sq(lab1.A-lab2.A) + sq(lab1.B-lab2.B) - sq(dC)
+
+	sC := 1.0 + k1*chroma1
+	sH := 1.0 + k2*chroma1
+
+	return math.Sqrt(sq(dL/kL) + sq(dC/sC) + math.Max(dH2, 0)/sq(sH))
+}
+
+// DistanceCIEDE2000 returns the CIEDE2000 color difference ΔE00 of two colors, relative to D65 and with the parametric
+// factors kL, kC and kH set to 1. Alpha is ignored.
+func DistanceCIEDE2000(c1, c2 color.Color) float64 {
+	lab1, lab2 := convertLab(c1, D65), convertLab(c2, D65)
+
+	// Adjust a* so that neutral colors get hue angles that agree with perception.
+	cBar7 := math.Pow((math.Hypot(lab1.A, lab1.B)+math.Hypot(lab2.A, lab2.B))/2.0, 7)
+	g := 0.5 * (1.0 - math.Sqrt(cBar7/(cBar7+pow25to7)))
+
+	chroma1, hue1 := ciede2000Polar((1.0+g)*lab1.A, lab1.B)
+	chroma2, hue2 := ciede2000Polar((1.0+g)*lab2.A, lab2.B)
+
+	dL := lab2.L - lab1.L
+	dC := chroma2 - chroma1
+
+	var dh float64
+	if chroma1*chroma2 != 0 {
+		dh = hue2 - hue1
+		switch {
+		case dh > 180.0:
+			dh -= 360.0
+
+		case dh < -180.0:
+			dh += 360.0
+		}
+	}
+	dH := 2.0 * math.Sqrt(chroma1*chroma2) * math.Sin(radians(dh/2.0))
+
+	lMean := (lab1.L + lab2.L) / 2.0
+	cMean := (chroma1 + chroma2) / 2.0
+
+	hMean := hue1 + hue2
+	if chroma1*chroma2 != 0 {
+		switch {
+		case math.Abs(hue1-hue2) <= 180.0:
+			hMean /= 2.0
+
+		case hMean < 360.0:
+			hMean = (hMean + 360.0) / 2.0
+
+		default:
+			hMean = (hMean - 360.0) / 2.0
+		}
+	}
+
+	t := 1.0 -
+		0.17*math.Cos(radians(hMean-30.0)) +
+		0.24*math.Cos(radians(2.0*hMean)) +
+		0.32*math.Cos(radians(3.0*hMean+6.0)) -
+		0.20*math.Cos(radians(4.0*hMean-63.0))
+
+	dTheta := 30.0 * math.Exp(-sq((hMean-275.0)/25.0))
+	cMean7 := math.Pow(cMean, 7)
+	rC := 2.0 * math.Sqrt(cMean7/(cMean7+pow25to7))
+
+	sL := 1.0 + 0.015*sq(lMean-50.0)/math.Sqrt(20.0+sq(lMean-50.0))
+	sC := 1.0 + 0.045*cMean
+	sH := 1.0 + 0.015*cMean*t
+	rT := -math.Sin(radians(2.0*dTheta)) * rC
+
+	return math.Sqrt(sq(dL/sL) + sq(dC/sC) + sq(dH/sH) + rT*(dC/sC)*(dH/sH))
+}
+
+// DistanceCMC returns the CMC l:c color difference of two colors, where l and c weigh lightness and chroma. Use 2:1 for
+// acceptability and 1:1 for perceptibility. CMC is not symmetric, c1 is the reference color. Alpha is ignored.
+func DistanceCMC(c1, c2 color.Color, l, c float64) float64 {
+	lab1, lab2 := convertLab(c1, D65), convertLab(c2, D65)
+
+	chroma1, hue1 := toPolar(lab1.A, lab1.B)
+
+	dL := lab1.L - lab2.L
+	dC := chroma1 - math.Hypot(lab2.A, lab2.B)
+	dH2 := sq(lab1.A-lab2.A) + sq(lab1.B-lab2.B) - sq(dC)
+
+	t := 0.36 + math.Abs(0.4*math.Cos(radians(hue1+35.0)))
+	if hue1 >= 164.0 && hue1 <= 345.0 {
+		t = 0.56 + math.Abs(0.2*math.Cos(radians(hue1+168.0)))
+	}
+
+	chroma14 := sq(sq(chroma1))
+	f := math.Sqrt(chroma14 / (chroma14 + 1900.0))
+
+	sL := 0.511
+	if lab1.L >= 16.0 {
+		sL = 0.040975 * lab1.L / (1.0 + 0.01765*lab1.L)
+	}
+	sC := 0.0638*chroma1/(1.0+0.0131*chroma1) + 0.638
+	sH := sC * (f*t + 1.0 - f)
+
+	return math.Sqrt(sq(dL/(l*sL)) + sq(dC/(c*sC)) + math.Max(dH2, 0)/sq(sH))
+}
+
+// DistanceOK returns the color difference ΔEOK of two colors, the Euclidean distance between them in OKLab. It is the
+// metric used by CSS gamut mapping, where a difference of 0.02 is just noticeable. Alpha is ignored.
+func DistanceOK(c1, c2 color.Color) float64 {
+	lab1, lab2 := convertOKLab(c1), convertOKLab(c2)
+
+	return math.Sqrt(sq(lab1.L-lab2.L) + sq(lab1.A-lab2.A) + sq(lab1.B-lab2.B))
+}
+
+// pow25to7 is 25⁷, the chroma at which the CIEDE2000 a* adjustment and rotation term are halfway.
+const pow25to7 = 6103515625.0
+
+// ciede2000Polar returns chroma and hue in degrees. Unlike toPolar the hue is exact for very small chroma.
+func ciede2000Polar(a, b float64) (float64, float64) {
+	if a == 0 && b == 0 {
+		return 0, 0
+	}
+
+	return math.Hypot(a, b), math.Mod(math.Atan2(b, a)*180.0/math.Pi+360.0, 360.0)
+}
+
+func radians(deg float64) float64 {
+	return deg * math.Pi / 180.0
+}
+
+func sq(x float64) float64 {
+	return x * x
+}
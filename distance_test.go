@@ -0,0 +1,243 @@
+package colorx
+
+import (
+	"image/color"
+	"testing"
+
+	"github.com/somebadcode/go-colorx/v2/internal/mathx"
+)
+
+// sharma is the CIEDE2000 test data from G. Sharma, W. Wu and E. N. Dalal, "The CIEDE2000 color-difference formula:
+// Implementation notes, supplementary test data, and mathematical observations", Color Research & Application, 2005.
+var sharma = []struct {
+	lab1 Lab
+	lab2 Lab
+	want float64
+}{
+	{Lab{L: 50.0000, A: 2.6772, B: -79.7751}, Lab{L: 50.0000, A: 0.0000, B: -82.7485}, 2.0425},
+	{Lab{L: 50.0000, A: 3.1571, B: -77.2803}, Lab{L: 50.0000, A: 0.0000, B: -82.7485}, 2.8615},
+	{Lab{L: 50.0000, A: 2.8361, B: -74.0200}, Lab{L: 50.0000, A: 0.0000, B: -82.7485}, 3.4412},
+	{Lab{L: 50.0000, A: -1.3802, B: -84.2814}, Lab{L: 50.0000, A: 0.0000, B: -82.7485}, 1.0000},
+	{Lab{L: 50.0000, A: -1.1848, B: -84.8006}, Lab{L: 50.0000, A: 0.0000, B: -82.7485}, 1.0000},
+	{Lab{L: 50.0000, A: -0.9009, B: -85.5211}, Lab{L: 50.0000, A: 0.0000, B: -82.7485}, 1.0000},
+	{Lab{L: 50.0000, A: 0.0000, B: 0.0000}, Lab{L: 50.0000, A: -1.0000, B: 2.0000}, 2.3669},
+	{Lab{L: 50.0000, A: -1.0000, B: 2.0000}, Lab{L: 50.0000, A: 0.0000, B: 0.0000}, 2.3669},
+	{Lab{L: 50.0000, A: 2.4900, B: -0.0010}, Lab{L: 50.0000, A: -2.4900, B: 0.0009}, 7.1792},
+	{Lab{L: 50.0000, A: 2.4900, B: -0.0010}, Lab{L: 50.0000, A: -2.4900, B: 0.0010}, 7.1792},
+	{Lab{L: 50.0000, A: 2.4900, B: -0.0010}, Lab{L: 50.0000, A: -2.4900, B: 0.0011}, 7.2195},
+	{Lab{L: 50.0000, A: 2.4900, B: -0.0010}, Lab{L: 50.0000, A: -2.4900, B: 0.0012}, 7.2195},
+	{Lab{L: 50.0000, A: -0.0010, B: 2.4900}, Lab{L: 50.0000, A: 0.0009, B: -2.4900}, 4.8045},
+	{Lab{L: 50.0000, A: -0.0010, B: 2.4900}, Lab{L: 50.0000, A: 0.0010, B: -2.4900}, 4.8045},
+	{Lab{L: 50.0000, A: -0.0010, B: 2.4900}, Lab{L: 50.0000, A: 0.0011, B: -2.4900}, 4.7461},
+	{Lab{L: 50.0000, A: 2.5000, B: 0.0000}, Lab{L: 50.0000, A: 0.0000, B: -2.5000}, 4.3065},
+	{Lab{L: 50.0000, A: 2.5000, B: 0.0000}, Lab{L: 73.0000, A: 25.0000, B: -18.0000}, 27.1492},
+	{Lab{L: 50.0000, A: 2.5000, B: 0.0000}, Lab{L: 61.0000, A: -5.0000, B: 29.0000}, 22.8977},
+	{Lab{L: 50.0000, A: 2.5000, B: 0.0000}, Lab{L: 56.0000, A: -27.0000, B: -3.0000}, 31.9030},
+	{Lab{L: 50.0000, A: 2.5000, B: 0.0000}, Lab{L: 58.0000, A: 24.0000, B: 15.0000}, 19.4535},
+	{Lab{L: 50.0000, A: 2.5000, B: 0.0000}, Lab{L: 50.0000, A: 3.1736, B: 0.5854}, 1.0000},
+	{Lab{L: 50.0000, A: 2.5000, B: 0.0000}, Lab{L: 50.0000, A: 3.2972, B: 0.0000}, 1.0000},
+	{Lab{L: 50.0000, A: 2.5000, B: 0.0000}, Lab{L: 50.0000, A: 1.8634, B: 0.5757}, 1.0000},
+	{Lab{L: 50.0000, A: 2.5000, B: 0.0000}, Lab{L: 50.0000, A: 3.2592, B: 0.3350}, 1.0000},
+	{Lab{L: 60.2574, A: -34.0099, B: 36.2677}, Lab{L: 60.4626, A: -34.1751, B: 39.4387}, 1.2644},
+	{Lab{L: 63.0109, A: -31.0961, B: -5.8663}, Lab{L: 62.8187, A: -29.7946, B: -4.0864}, 1.2630},
+	{Lab{L: 61.2901, A: 3.7196, B: -5.3901}, Lab{L: 61.4292, A: 2.2480, B: -4.9620}, 1.8731},
+	{Lab{L: 35.0831, A: -44.1164, B: 3.7933}, Lab{L: 35.0232, A: -40.0716, B: 1.5901}, 1.8645},
+	{Lab{L: 22.7233, A: 20.0904, B: -46.6940}, Lab{L: 23.0331, A: 14.9730, B: -42.5619}, 2.0373},
+	{Lab{L: 36.4612, A: 47.8580, B: 18.3852}, Lab{L: 36.2715, A: 50.5065, B: 21.2231}, 1.4146},
+	{Lab{L: 90.8027, A: -2.0831, B: 1.4410}, Lab{L: 91.1528, A: -1.6435, B: 0.0447}, 1.4441},
+	{Lab{L: 90.9257, A: -0.5406, B: -0.9208}, Lab{L: 88.6381, A: -0.8985, B: -0.7239}, 1.5381},
+	{Lab{L: 6.7747, A: -0.2908, B: -2.4247}, Lab{L: 5.8714, A: -0.0985, B: -2.2286}, 0.6377},
+	{Lab{L: 2.0776, A: 0.0795, B: -1.1350}, Lab{L: 0.9033, A: -0.0636, B: -0.5514}, 0.9082},
+}
+
+func TestDistanceCIEDE2000(t *testing.T) {
+	for i, tt := range sharma {
+		got := DistanceCIEDE2000(tt.lab1, tt.lab2)
+		if !mathx.EqualP(got, tt.want, 1e-4) {
+			t.Errorf("pair %d: DistanceCIEDE2000() = %.4f, want %.4f", i+1, got, tt.want)
+		}
+
+		// CIEDE2000 is symmetric.
+		if back := DistanceCIEDE2000(tt.lab2, tt.lab1); !mathx.EqualP(back, got, 1e-9) {
+			t.Errorf("pair %d: DistanceCIEDE2000() reversed = %.4f, want %.4f", i+1, back, got)
+		}
+	}
+}
+
+func TestDistanceCIE76(t *testing.T) {
+	type args struct {
+		c1 color.Color
+		c2 color.Color
+	}
+	tests := []struct {
+		name string
+		args args
+		want float64
+	}{
+		{
+			name: "same",
+			args: args{c1: color.White, c2: CSS{R: 0xFF, G: 0xFF, B: 0xFF, Opacity: 1.0}},
+			want: 0.0,
+		},
+		{
+			name: "lab",
+			args: args{c1: Lab{L: 50.0, A: 3.0}, c2: Lab{L: 50.0, B: 4.0}},
+			want: 5.0,
+		},
+		{
+			name: "black_white",
+			args: args{c1: color.Black, c2: color.White},
+			want: 100.0,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := DistanceCIE76(tt.args.c1, tt.args.c2); !mathx.EqualP(got, tt.want, 1e-4) {
+				t.Errorf("DistanceCIE76() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestDistanceCIE94(t *testing.T) {
+	type args struct {
+		c1  color.Color
+		c2  color.Color
+		app CIE94Application
+	}
+	tests := []struct {
+		name string
+		args args
+		want float64
+	}{
+		{
+			name: "graphic_arts",
+			args: args{
+				c1:  Lab{L: 50.0, A: 2.6772, B: -79.7751},
+				c2:  Lab{L: 50.0, A: 0.0, B: -82.7485},
+				app: CIE94GraphicArts,
+			},
+			want: 1.3950,
+		},
+		{
+			name: "unknown_application",
+			args: args{
+				c1:  Lab{L: 50.0, A: 2.6772, B: -79.7751},
+				c2:  Lab{L: 50.0, A: 0.0, B: -82.7485},
+				app: CIE94Application(-1),
+			},
+			want: 1.3950,
+		},
+		{
+			name: "textiles",
+			args: args{
+				c1:  Lab{L: 50.0, A: 2.6772, B: -79.7751},
+				c2:  Lab{L: 50.0, A: 0.0, B: -82.7485},
+				app: CIE94Textiles,
+			},
+			want: 1.4230,
+		},
+		{
+			name: "lightness_textiles",
+			args: args{
+				c1:  Lab{L: 60.0},
+				c2:  Lab{L: 50.0},
+				app: CIE94Textiles,
+			},
+			want: 5.0,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := DistanceCIE94(tt.args.c1, tt.args.c2, tt.args.app); !mathx.EqualP(got, tt.want, 1e-4) {
+				t.Errorf("DistanceCIE94() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestDistanceCMC(t *testing.T) {
+	type args struct {
+		c1 color.Color
+		c2 color.Color
+		l  float64
+		c  float64
+	}
+	tests := []struct {
+		name string
+		args args
+		want float64
+	}{
+		{
+			name: "acceptability",
+			args: args{
+				c1: Lab{L: 50.0, A: 2.6772, B: -79.7751},
+				c2: Lab{L: 50.0, A: 0.0, B: -82.7485},
+				l:  2.0,
+				c:  1.0,
+			},
+			want: 1.7387,
+		},
+		{
+			name: "dark_lightness",
+			args: args{
+				c1: Lab{L: 10.0},
+				c2: Lab{L: 15.11},
+				l:  1.0,
+				c:  1.0,
+			},
+			want: 10.0,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := DistanceCMC(tt.args.c1, tt.args.c2, tt.args.l, tt.args.c); !mathx.EqualP(got, tt.want, 1e-4) {
+				t.Errorf("DistanceCMC() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestDistanceOK(t *testing.T) {
+	type args struct {
+		c1 color.Color
+		c2 color.Color
+	}
+	tests := []struct {
+		name string
+		args args
+		want float64
+	}{
+		{
+			name: "oklab",
+			args: args{c1: OKLab{L: 0.5, A: 0.03}, c2: OKLab{L: 0.5, B: -0.04}},
+			want: 0.05,
+		},
+		{
+			name: "black_white",
+			args: args{c1: color.Black, c2: color.White},
+			want: 1.0,
+		},
+		{
+			name: "hue_wrap",
+			args: args{c1: OKLCh{L: 0.5, C: 0.1, H: 359.0}, c2: OKLCh{L: 0.5, C: 0.1, H: 1.0}},
+			want: 0.0035,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := DistanceOK(tt.args.c1, tt.args.c2); !mathx.EqualP(got, tt.want, 1e-4) {
+				t.Errorf("DistanceOK() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func BenchmarkDistanceCIEDE2000(b *testing.B) {
+	c1 := CSS{R: 0xFF, G: 0xBF, B: 0x80, Opacity: 1.0}
+	c2 := CSS{R: 0xF0, G: 0xC0, B: 0x90, Opacity: 1.0}
+
+	for n := 0; n < b.N; n++ {
+		DistanceCIEDE2000(c1, c2)
+	}
+}
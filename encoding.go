@@ -0,0 +1,300 @@
+package colorx
+
+import (
+	"image/color"
+)
+
+// The color types implement encoding.TextMarshaler and encoding.TextUnmarshaler with the CSS syntax of their String
+// methods, which makes encoding/json and other encoders that use the text hooks write them as strings such as
+// "#ff8800" or "hsl(30 100% 50%)". UnmarshalText accepts every notation that Parse understands and converts the color
+// to the type of the receiver.
+//
+// Like String, MarshalText rounds the components of the floating point types to four decimals, and the opacity of CSS
+// to two, so a round trip through text is only exact to that precision. Encode CSSFields, HSLAFields and HSVAFields,
+// or the fields of the other types, to keep every bit.
+
+// MarshalText returns the color as the rgb() or rgba() function, such as "rgba(255,136,0,0.5)".
+func (c CSS) MarshalText() ([]byte, error) {
+	return c.AppendText(nil), nil
+}
+
+// UnmarshalText parses the color with ParseCSS, which rounds the channels to 8 bits.
+func (c *CSS) UnmarshalText(text []byte) error {
+	v, err := ParseCSS(string(text))
+	if err != nil {
+		return err
+	}
+
+	*c = v
+
+	return nil
+}
+
+// MarshalText returns the color as the hsl() function, such as "hsl(30 100% 50% / 0.5)".
+func (hsla HSLA) MarshalText() ([]byte, error) {
+	return hsla.AppendText(nil), nil
+}
+
+// UnmarshalText parses the color and converts it to HSL. A color given as hsl() keeps its components without
+// rounding.
+func (hsla *HSLA) UnmarshalText(text []byte) error {
+	c, err := unmarshalColor(text)
+	if err != nil {
+		return err
+	}
+
+	*hsla = hslaModel(c).(HSLA)
+
+	return nil
+}
+
+// MarshalText returns the color as the hsl() function, since CSS has no function for HSV.
+func (hsva HSVA) MarshalText() ([]byte, error) {
+	return hsva.AppendText(nil), nil
+}
+
+// UnmarshalText parses the color and converts it to HSV. A color given as hsl() is converted without going through
+// RGB, so that it reads back what MarshalText wrote.
+func (hsva *HSVA) UnmarshalText(text []byte) error {
+	c, err := unmarshalColor(text)
+	if err != nil {
+		return err
+	}
+
+	if hsla, ok := c.(HSLA); ok {
+		*hsva = hsla.hsva()
+	} else {
+		*hsva = hsvaModel(c).(HSVA)
+	}
+
+	return nil
+}
+
+// MarshalText returns the color as the hwb() function, such as "hwb(30 0% 0%)".
+func (hwba HWBA) MarshalText() ([]byte, error) {
+	return []byte(hwba.String()), nil
+}
+
+// UnmarshalText parses the color and converts it to HWB. A color given as hwb() keeps its components.
+func (hwba *HWBA) UnmarshalText(text []byte) error {
+	c, err := unmarshalColor(text)
+	if err != nil {
+		return err
+	}
+
+	*hwba = hwbaModel(c).(HWBA)
+
+	return nil
+}
+
+// MarshalText returns the color as the color() function, such as "color(srgb 1 0.5 0)".
+func (c SRGB) MarshalText() ([]byte, error) {
+	return []byte(c.String()), nil
+}
+
+// UnmarshalText parses the color and converts it to sRGB without clipping it, so that a wide-gamut color keeps
+// channels outside of [0, 1].
+func (c *SRGB) UnmarshalText(text []byte) error {
+	v, err := unmarshalColor(text)
+	if err != nil {
+		return err
+	}
+
+	*c = srgbModel(v).(SRGB)
+
+	return nil
+}
+
+// MarshalText returns the color as the color() function, such as "color(srgb-linear 1 0.2 0)".
+func (c LinearSRGB) MarshalText() ([]byte, error) {
+	return []byte(c.String()), nil
+}
+
+// UnmarshalText parses the color and converts it to linear-light sRGB without clipping it.
+func (c *LinearSRGB) UnmarshalText(text []byte) error {
+	v, err := unmarshalColor(text)
+	if err != nil {
+		return err
+	}
+
+	*c = linearSRGBModel(v).(LinearSRGB)
+
+	return nil
+}
+
+// MarshalText returns the color as the color() function, such as "color(display-p3 1 0.5 0)".
+func (c DisplayP3) MarshalText() ([]byte, error) {
+	return []byte(c.String()), nil
+}
+
+// UnmarshalText parses the color and converts it to Display P3. A color given as color(display-p3) keeps its channels.
+func (c *DisplayP3) UnmarshalText(text []byte) error {
+	v, err := unmarshalColor(text)
+	if err != nil {
+		return err
+	}
+
+	*c = displayP3Model(v).(DisplayP3)
+
+	return nil
+}
+
+// MarshalText returns the color as the color() function, such as "color(rec2020 1 0.5 0)".
+func (c Rec2020) MarshalText() ([]byte, error) {
+	return []byte(c.String()), nil
+}
+
+// UnmarshalText parses the color and converts it to Rec. 2020.
+func (c *Rec2020) UnmarshalText(text []byte) error {
+	v, err := unmarshalColor(text)
+	if err != nil {
+		return err
+	}
+
+	*c = rec2020Model(v).(Rec2020)
+
+	return nil
+}
+
+// MarshalText returns the color as the color() function, such as "color(a98-rgb 1 0.5 0)".
+func (c A98RGB) MarshalText() ([]byte, error) {
+	return []byte(c.String()), nil
+}
+
+// UnmarshalText parses the color and converts it to Adobe RGB (1998).
+func (c *A98RGB) UnmarshalText(text []byte) error {
+	v, err := unmarshalColor(text)
+	if err != nil {
+		return err
+	}
+
+	*c = a98RGBModel(v).(A98RGB)
+
+	return nil
+}
+
+// MarshalText returns the color as the color() function, such as "color(prophoto-rgb 1 0.5 0)".
+func (c ProPhotoRGB) MarshalText() ([]byte, error) {
+	return []byte(c.String()), nil
+}
+
+// UnmarshalText parses the color and converts it to ProPhoto RGB, adapting it to the D50 white point.
+func (c *ProPhotoRGB) UnmarshalText(text []byte) error {
+	v, err := unmarshalColor(text)
+	if err != nil {
+		return err
+	}
+
+	*c = proPhotoRGBModel(v).(ProPhotoRGB)
+
+	return nil
+}
+
+// MarshalText returns the color as the color() function with the xyz-d50 or xyz-d65 space.
+func (xyz XYZ) MarshalText() ([]byte, error) {
+	return []byte(xyz.String()), nil
+}
+
+// UnmarshalText parses the color and converts it to XYZ. A color given as color() with an XYZ space keeps its
+// reference white, other colors are relative to D65.
+func (xyz *XYZ) UnmarshalText(text []byte) error {
+	c, err := unmarshalColor(text)
+	if err != nil {
+		return err
+	}
+
+	if v, ok := c.(XYZ); ok {
+		*xyz = v
+	} else {
+		*xyz = convertXYZ(c, D65)
+	}
+
+	return nil
+}
+
+// MarshalText returns the color as the lab() function, relative to D50 like CSS.
+func (lab Lab) MarshalText() ([]byte, error) {
+	return []byte(lab.String()), nil
+}
+
+// UnmarshalText parses the color and converts it to Lab relative to D50, the reference white of the CSS lab()
+// function.
+func (lab *Lab) UnmarshalText(text []byte) error {
+	c, err := unmarshalColor(text)
+	if err != nil {
+		return err
+	}
+
+	*lab = convertLab(c, D50)
+
+	return nil
+}
+
+// MarshalText returns the color as the lch() function, relative to D50 like CSS.
+func (lch LCh) MarshalText() ([]byte, error) {
+	return []byte(lch.String()), nil
+}
+
+// UnmarshalText parses the color and converts it to LCh relative to D50, the reference white of the CSS lch()
+// function.
+func (lch *LCh) UnmarshalText(text []byte) error {
+	c, err := unmarshalColor(text)
+	if err != nil {
+		return err
+	}
+
+	*lch = convertLCh(c, D50)
+
+	return nil
+}
+
+// MarshalText returns the color as the oklab() function, such as "oklab(0.5 0.1 -0.1)".
+func (lab OKLab) MarshalText() ([]byte, error) {
+	return []byte(lab.String()), nil
+}
+
+// UnmarshalText parses the color and converts it to OKLab.
+func (lab *OKLab) UnmarshalText(text []byte) error {
+	c, err := unmarshalColor(text)
+	if err != nil {
+		return err
+	}
+
+	*lab = oklabModel(c).(OKLab)
+
+	return nil
+}
+
+// MarshalText returns the color as the oklch() function, such as "oklch(0.5 0.1 200)".
+func (lch OKLCh) MarshalText() ([]byte, error) {
+	return []byte(lch.String()), nil
+}
+
+// UnmarshalText parses the color and converts it to OKLCh. A color given as oklch() keeps its components.
+func (lch *OKLCh) UnmarshalText(text []byte) error {
+	c, err := unmarshalColor(text)
+	if err != nil {
+		return err
+	}
+
+	*lch = oklchModel(c).(OKLCh)
+
+	return nil
+}
+
+// unmarshalColor parses the text of UnmarshalText without rounding the sRGB notations to 8 bits.
+func unmarshalColor(text []byte) (color.Color, error) {
+	return Parse(string(text), exactly())
+}
+
+// CSSFields is CSS without the text marshaling, for encoding the color as a struct of its fields, such as
+// {"R":255,"G":136,"B":0,"Opacity":1} in JSON. Convert a CSS to CSSFields to opt in to the struct form.
+type CSSFields CSS
+
+// HSLAFields is HSLA without the text marshaling, for encoding the color as a struct of its fields. Convert an HSLA to
+// HSLAFields to opt in to the struct form.
+type HSLAFields HSLA
+
+// HSVAFields is HSVA without the text marshaling, for encoding the color as a struct of its fields. Convert an HSVA to
+// HSVAFields to opt in to the struct form.
+type HSVAFields HSVA
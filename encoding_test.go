@@ -0,0 +1,223 @@
+package colorx
+
+import (
+	"encoding"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math"
+	"reflect"
+	"testing"
+
+	"github.com/somebadcode/go-colorx/v2/internal/mathx"
+)
+
+func TestCSS_UnmarshalText(t *testing.T) {
+	tests := []struct {
+		name    string
+		json    string
+		want    CSS
+		wantErr error
+	}{
+		{
+			name: "hex",
+			json: `{"Color":"#ff8800"}`,
+			want: CSS{R: 0xFF, G: 0x88, Opacity: 1.0},
+		},
+		{
+			name: "hsl",
+			json: `{"Color":"hsl(30 100% 50%)"}`,
+			want: CSS{R: 0xFF, G: 0x80, Opacity: 1.0},
+		},
+		{
+			name: "name",
+			json: `{"Color":"rebeccapurple"}`,
+			want: CSS{R: 0x66, G: 0x33, B: 0x99, Opacity: 1.0},
+		},
+		{
+			name: "short_hex_alpha",
+			json: `{"Color":"#ff88"}`,
+			want: CSS{R: 0xFF, G: 0xFF, B: 0x88, Opacity: float64(0x88) / 0xFF},
+		},
+		{
+			name:    "syntax",
+			json:    `{"Color":"rgb(1 2"}`,
+			wantErr: ErrUnexpectedEnd,
+		},
+		{
+			name:    "not_a_string",
+			json:    `{"Color":{"R":255}}`,
+			wantErr: &json.UnmarshalTypeError{},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var v struct{ Color CSS }
+
+			err := json.Unmarshal([]byte(tt.json), &v)
+			if tt.wantErr == nil && err != nil {
+				t.Fatalf("Unmarshal() error = %v", err)
+			}
+			if tt.wantErr != nil {
+				if err == nil || (!errors.Is(err, tt.wantErr) && reflect.TypeOf(err) != reflect.TypeOf(tt.wantErr)) {
+					t.Fatalf("Unmarshal() error = %v, wantErr %v", err, tt.wantErr)
+				}
+				return
+			}
+			if v.Color != tt.want {
+				t.Errorf("Unmarshal() got = %v, want %v", v.Color, tt.want)
+			}
+		})
+	}
+}
+
+func TestMarshalText_roundTrip(t *testing.T) {
+	tests := []struct {
+		name string
+		c    encoding.TextMarshaler
+		want string
+	}{
+		{name: "css", c: CSS{R: 0xFF, G: 0x88, Opacity: 0.5}, want: "rgba(255,136,0,0.5)"},
+		{name: "hsla", c: HSLA{H: 200.5, S: 0.25, L: 0.4, A: 0.5}, want: "hsl(200.5 25% 40% / 0.5)"},
+		{name: "hsla_missing", c: HSLA{H: math.NaN(), L: 0.5, A: 1.0}, want: "hsl(none 0% 50%)"},
+		{name: "hsva", c: HSVA{H: 120.0, S: 0.5, V: 1.0, A: 1.0}, want: "hsl(120 100% 75%)"},
+		{name: "hwba", c: HWBA{H: 60.0, W: 0.2, B: 0.3, A: 1.0}, want: "hwb(60 20% 30%)"},
+		{name: "srgb", c: SRGB{R: 1.0, G: 0.5, A: 1.0}, want: "color(srgb 1 0.5 0)"},
+		{name: "linear_srgb", c: LinearSRGB{R: 0.25, G: 0.5, B: 1.0, A: 1.0}, want: "color(srgb-linear 0.25 0.5 1)"},
+		{name: "display_p3", c: DisplayP3{R: 1.0, A: 0.5}, want: "color(display-p3 1 0 0 / 0.5)"},
+		{name: "rec2020", c: Rec2020{G: 1.0, A: 1.0}, want: "color(rec2020 0 1 0)"},
+		{name: "a98_rgb", c: A98RGB{B: 1.0, A: 1.0}, want: "color(a98-rgb 0 0 1)"},
+		{name: "prophoto_rgb", c: ProPhotoRGB{R: 0.5, G: 0.5, B: 0.5, A: 1.0}, want: "color(prophoto-rgb 0.5 0.5 0.5)"},
+		{name: "xyz", c: XYZ{X: 0.2, Y: 0.3, Z: 0.4, A: 1.0, White: D50}, want: "color(xyz-d50 0.2 0.3 0.4)"},
+		{name: "lab", c: Lab{L: 50.0, A: 20.0, B: -30.0, Alpha: 1.0, White: D50}, want: "lab(50 20 -30)"},
+		{name: "lch", c: LCh{L: 50.0, C: 30.0, H: 120.0, Alpha: 1.0, White: D50}, want: "lch(50 30 120)"},
+		{name: "oklab", c: OKLab{L: 0.5, A: 0.1, B: -0.1, Alpha: 1.0}, want: "oklab(0.5 0.1 -0.1)"},
+		{name: "oklch", c: OKLCh{L: 0.5, C: 0.1, H: 200.0, Alpha: 0.8}, want: "oklch(0.5 0.1 200 / 0.8)"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			b, err := json.Marshal(tt.c)
+			if err != nil {
+				t.Fatalf("Marshal() error = %v", err)
+			}
+			if want := `"` + tt.want + `"`; string(b) != want {
+				t.Fatalf("Marshal() got = %s, want %s", b, want)
+			}
+
+			got := reflect.New(reflect.TypeOf(tt.c))
+			if err := json.Unmarshal(b, got.Interface()); err != nil {
+				t.Fatalf("Unmarshal() error = %v", err)
+			}
+			if s := fmt.Sprint(got.Elem().Interface()); s != fmt.Sprint(tt.c) {
+				t.Errorf("Unmarshal() got = %v, want %v", s, tt.c)
+			}
+		})
+	}
+}
+
+func TestMarshalText_precision(t *testing.T) {
+	tests := []struct {
+		name string
+		c    interface{}
+		want interface{}
+	}{
+		{name: "css", c: CSS{R: 0xFF, Opacity: 0.125}, want: CSS{R: 0xFF, Opacity: 0.13}},
+		{name: "srgb", c: SRGB{R: 0.123456, G: 0.5, A: 1.0}, want: SRGB{R: 0.1235, G: 0.5, A: 1.0}},
+		{
+			name: "lab",
+			c:    Lab{L: 50.000049, A: 20.00005, Alpha: 1.0, White: D50},
+			want: Lab{L: 50.0, A: 20.0001, Alpha: 1.0, White: D50},
+		},
+		{
+			name: "oklch",
+			c:    OKLCh{L: 0.56789, C: 0.1, H: 200.123456, Alpha: 1.0},
+			want: OKLCh{L: 0.5679, C: 0.1, H: 200.1235, Alpha: 1.0},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			b, err := json.Marshal(tt.c)
+			if err != nil {
+				t.Fatalf("Marshal() error = %v", err)
+			}
+
+			got := reflect.New(reflect.TypeOf(tt.c))
+			if err := json.Unmarshal(b, got.Interface()); err != nil {
+				t.Fatalf("Unmarshal() error = %v", err)
+			}
+
+			// The components are rounded to the precision of String, which is lossy.
+			if !reflect.DeepEqual(got.Elem().Interface(), tt.want) {
+				t.Errorf("Unmarshal(%s) got = %#v, want %#v", b, got.Elem().Interface(), tt.want)
+			}
+		})
+	}
+}
+
+func TestHSLA_UnmarshalText(t *testing.T) {
+	var hsla HSLA
+	if err := hsla.UnmarshalText([]byte("hsl(200.5 25% 40% / 0.5)")); err != nil {
+		t.Fatalf("UnmarshalText() error = %v", err)
+	}
+
+	// hsl() is read without rounding to 8 bits.
+	if want := (HSLA{H: 200.5, S: 0.25, L: 0.4, A: 0.5}); !mathx.Equal(hsla.H, want.H) || !mathx.Equal(hsla.S, want.S) ||
+		!mathx.Equal(hsla.L, want.L) || !mathx.Equal(hsla.A, want.A) {
+		t.Errorf("UnmarshalText() got = %v, want %v", hsla, want)
+	}
+
+	if err := hsla.UnmarshalText([]byte("#ff0000")); err != nil {
+		t.Fatalf("UnmarshalText() error = %v", err)
+	}
+	if want := (HSLA{S: 1.0, L: 0.5, A: 1.0}); !mathx.Equal(hsla.H, want.H) || !mathx.Equal(hsla.S, want.S) ||
+		!mathx.Equal(hsla.L, want.L) || !mathx.Equal(hsla.A, want.A) {
+		t.Errorf("UnmarshalText() got = %v, want %v", hsla, want)
+	}
+}
+
+func TestHSVA_UnmarshalText(t *testing.T) {
+	want := HSVA{H: 200.0, S: 0.6, V: 0.8, A: 1.0}
+
+	var hsva HSVA
+	if err := hsva.UnmarshalText(want.AppendText(nil)); err != nil {
+		t.Fatalf("UnmarshalText() error = %v", err)
+	}
+
+	// The percentages of hsl() are written with four decimals.
+	const precision = 1e-6
+	if !mathx.EqualP(hsva.H, want.H, precision) || !mathx.EqualP(hsva.S, want.S, precision) ||
+		!mathx.EqualP(hsva.V, want.V, precision) || !mathx.EqualP(hsva.A, want.A, precision) {
+		t.Errorf("UnmarshalText() got = %#v, want %#v", hsva, want)
+	}
+}
+
+func TestFields(t *testing.T) {
+	tests := []struct {
+		name string
+		v    interface{}
+		want string
+	}{
+		{name: "css", v: CSSFields{R: 0xFF, G: 0x88, Opacity: 1.0}, want: `{"R":255,"G":136,"B":0,"Opacity":1}`},
+		{name: "hsla", v: HSLAFields{H: 30.0, S: 1.0, L: 0.5, A: 1.0}, want: `{"H":30,"S":1,"L":0.5,"A":1}`},
+		{name: "hsva", v: HSVAFields{H: 30.0, S: 1.0, V: 1.0, A: 1.0}, want: `{"H":30,"S":1,"V":1,"A":1}`},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			b, err := json.Marshal(tt.v)
+			if err != nil {
+				t.Fatalf("Marshal() error = %v", err)
+			}
+			if string(b) != tt.want {
+				t.Errorf("Marshal() got = %s, want %s", b, tt.want)
+			}
+
+			got := reflect.New(reflect.TypeOf(tt.v))
+			if err := json.Unmarshal(b, got.Interface()); err != nil {
+				t.Fatalf("Unmarshal() error = %v", err)
+			}
+			if !reflect.DeepEqual(got.Elem().Interface(), tt.v) {
+				t.Errorf("Unmarshal() got = %v, want %v", got.Elem().Interface(), tt.v)
+			}
+		})
+	}
+}
@@ -0,0 +1,58 @@
+package colorx
+
+import (
+	"flag"
+	"fmt"
+)
+
+// flagSyntaxes lists the notations that a CSSFlag accepts, for its error messages.
+const flagSyntaxes = "a hex color such as #0af, #00aaff or #00aaff80, a color name such as navy, or a CSS color " +
+	"function such as rgb(0 170 255), hsl(200 100% 50%), hwb(200 0% 0%) or oklch(0.7 0.15 230)"
+
+// flagFormatter writes the value of a CSSFlag in the notation that is the easiest to type back.
+var flagFormatter = NewFormatter(Shortest())
+
+// CSSFlag is a flag.Value that parses the color with ParseCSS, such as "--accent=#0af" or "--bg=navy". It also has the
+// Type method of the Value interface of github.com/spf13/pflag, so that a *CSSFlag can be passed to pflag as well.
+// Convert a *CSS to a *CSSFlag to use it as a flag.
+type CSSFlag CSS
+
+// NewCSSFlag sets the color that p points to to value and returns it as a *CSSFlag.
+func NewCSSFlag(p *CSS, value CSS) *CSSFlag {
+	*p = value
+
+	return (*CSSFlag)(p)
+}
+
+// Var defines a color flag with the name, default value and usage in the flag set. The argument p points to the CSS
+// variable that stores the value of the flag.
+func Var(fs *flag.FlagSet, p *CSS, name string, value CSS, usage string) {
+	fs.Var(NewCSSFlag(p, value), name, usage)
+}
+
+// String returns the color in its shortest notation, a name, a hexadecimal color or the rgb() function. A nil
+// *CSSFlag, which the flag package may call String on, returns an empty string.
+func (f *CSSFlag) String() string {
+	if f == nil {
+		return ""
+	}
+
+	return flagFormatter.Format(CSS(*f))
+}
+
+// Set parses the color with ParseCSS. The error lists the accepted notations.
+func (f *CSSFlag) Set(s string) error {
+	c, err := ParseCSS(s)
+	if err != nil {
+		return fmt.Errorf("%w, use %s", err, flagSyntaxes)
+	}
+
+	*f = CSSFlag(c)
+
+	return nil
+}
+
+// Type returns the name of the type of the value for the help of pflag.
+func (f *CSSFlag) Type() string {
+	return "color"
+}
@@ -0,0 +1,113 @@
+package colorx
+
+import (
+	"bytes"
+	"errors"
+	"flag"
+	"strings"
+	"testing"
+)
+
+func TestVar(t *testing.T) {
+	tests := []struct {
+		name    string
+		args    []string
+		want    CSS
+		wantErr string
+	}{
+		{
+			name: "default",
+			want: CSS{R: 0xFF, G: 0xFF, B: 0xFF, Opacity: 1.0},
+		},
+		{
+			name: "hex",
+			args: []string{"--accent=#0af"},
+			want: CSS{G: 0xAA, B: 0xFF, Opacity: 1.0},
+		},
+		{
+			name: "name",
+			args: []string{"-accent", "navy"},
+			want: CSS{B: 0x80, Opacity: 1.0},
+		},
+		{
+			name: "function",
+			args: []string{"--accent", "hsl(0 100% 50% / 50%)"},
+			want: CSS{R: 0xFF, Opacity: 0.5},
+		},
+		{
+			name:    "invalid",
+			args:    []string{"--accent=#0ag"},
+			wantErr: ErrInvalidHex.Error(),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			fs := flag.NewFlagSet("test", flag.ContinueOnError)
+			fs.SetOutput(&bytes.Buffer{})
+
+			var got CSS
+			Var(fs, &got, "accent", CSS{R: 0xFF, G: 0xFF, B: 0xFF, Opacity: 1.0}, "accent color")
+
+			// The flag package does not wrap the error of Set, so only its message is available.
+			err := fs.Parse(tt.args)
+			if (err != nil) != (tt.wantErr != "") || (err != nil && !strings.Contains(err.Error(), tt.wantErr)) {
+				t.Fatalf("Parse() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if err == nil && got != tt.want {
+				t.Errorf("Parse() got = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestCSSFlag_Set(t *testing.T) {
+	var c CSS
+	f := NewCSSFlag(&c, CSS{})
+
+	err := f.Set("blurple")
+	if !errors.Is(err, ErrUnknownName) {
+		t.Fatalf("Set() error = %v, want %v", err, ErrUnknownName)
+	}
+	for _, syntax := range []string{"#0af", "navy", "rgb(", "hsl(", "hwb(", "oklch("} {
+		if !strings.Contains(err.Error(), syntax) {
+			t.Errorf("Set() error = %q, want it to mention %q", err, syntax)
+		}
+	}
+}
+
+func TestCSSFlag_String(t *testing.T) {
+	tests := []struct {
+		name string
+		c    CSS
+		want string
+	}{
+		{name: "name", c: CSS{B: 0x80, Opacity: 1.0}, want: "navy"},
+		{name: "hex", c: CSS{G: 0xAA, B: 0xFF, Opacity: 1.0}, want: "#0af"},
+		{name: "translucent", c: CSS{R: 0x12, G: 0x34, B: 0x56, Opacity: float64(0x40) / 0xFF}, want: "#12345640"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			f := (*CSSFlag)(&tt.c)
+			if got := f.String(); got != tt.want {
+				t.Errorf("String() = %v, want %v", got, tt.want)
+			}
+
+			// The value can be set from its string.
+			var c CSS
+			if err := NewCSSFlag(&c, CSS{}).Set(f.String()); err != nil || c != tt.c {
+				t.Errorf("Set(%q) = %v, %v, want %v", f, c, err, tt.c)
+			}
+			if got := f.Type(); got != "color" {
+				t.Errorf("Type() = %v, want color", got)
+			}
+		})
+	}
+}
+
+func TestCSSFlag_String_nil(t *testing.T) {
+	// The flag package may call String on a nil pointer.
+	var f *CSSFlag
+	if got := f.String(); got != "" {
+		t.Errorf("String() = %q, want empty", got)
+	}
+}
@@ -0,0 +1,221 @@
+package colorx
+
+import (
+	"fmt"
+	"image/color"
+	"strconv"
+	"unicode/utf8"
+)
+
+// Format implements fmt.Formatter. The verbs %x and %X write the hexadecimal notation in lower and upper case, %s and
+// %v write the rgb() or rgba() function like String and %q quotes it, %+v writes the components of the color in the
+// CSS, HSLA and HSVA models for debugging and %#v writes the Go syntax. The precision is the number of decimals of the
+// alpha, such as "%.4s", and the width pads the text like it does for strings.
+func (c CSS) Format(s fmt.State, verb rune) {
+	formatColor(s, verb, c, defaultPrecision,
+		func(dst []byte, decimals int) []byte {
+			f := defaultFormatter
+			f.precision = decimals
+
+			return f.appendFunction(dst, c)
+		},
+		func(dst []byte, decimals int) []byte {
+			dst = appendCSSFields(dst, c, decimals)
+			dst = appendHSLAFields(append(dst, ' '), hslaModel(c).(HSLA), decimals)
+
+			return appendHSVAFields(append(dst, ' '), hsvaModel(c).(HSVA), decimals)
+		},
+		func(dst []byte) []byte {
+			dst = append(dst, "colorx.CSS{R:0x"...)
+			dst = strconv.AppendUint(dst, uint64(c.R), 16)
+			dst = append(dst, ", G:0x"...)
+			dst = strconv.AppendUint(dst, uint64(c.G), 16)
+			dst = append(dst, ", B:0x"...)
+			dst = strconv.AppendUint(dst, uint64(c.B), 16)
+			dst = append(dst, ", Opacity:"...)
+			dst = strconv.AppendFloat(dst, c.Opacity, 'g', -1, 64)
+
+			return append(dst, '}')
+		})
+}
+
+// Format implements fmt.Formatter. The verbs %x and %X write the hexadecimal notation of the color as CSS in lower and
+// upper case, %s and %v write the hsl() function like String and %q quotes it, %+v writes the components of the color
+// in the HSLA, CSS and HSVA models for debugging and %#v writes the Go syntax. The precision is the number of decimals
+// of the components, such as "%.1s", and the width pads the text like it does for strings.
+func (hsla HSLA) Format(s fmt.State, verb rune) {
+	formatColor(s, verb, hsla, cssDecimals,
+		func(dst []byte, decimals int) []byte {
+			return appendHueFunction(dst, "hsl(", hsla.H, hsla.S, hsla.L, hsla.A, decimals)
+		},
+		func(dst []byte, decimals int) []byte {
+			dst = appendHSLAFields(dst, hsla, decimals)
+			dst = appendCSSFields(append(dst, ' '), cssModel(hsla).(CSS), decimals)
+
+			return appendHSVAFields(append(dst, ' '), hsla.hsva(), decimals)
+		},
+		func(dst []byte) []byte {
+			return appendGoFields(dst, "colorx.HSLA", "H", "S", "L", "A", hsla.H, hsla.S, hsla.L, hsla.A)
+		})
+}
+
+// Format implements fmt.Formatter. The verbs %x and %X write the hexadecimal notation of the color as CSS in lower and
+// upper case, %s and %v write the hsl() function like String and %q quotes it, %+v writes the components of the color
+// in the HSVA, CSS and HSLA models for debugging and %#v writes the Go syntax. The precision is the number of decimals
+// of the components, such as "%.1s", and the width pads the text like it does for strings.
+func (hsva HSVA) Format(s fmt.State, verb rune) {
+	formatColor(s, verb, hsva, cssDecimals,
+		func(dst []byte, decimals int) []byte {
+			hsla := hsva.hsla()
+
+			return appendHueFunction(dst, "hsl(", hsla.H, hsla.S, hsla.L, hsla.A, decimals)
+		},
+		func(dst []byte, decimals int) []byte {
+			dst = appendHSVAFields(dst, hsva, decimals)
+			dst = appendCSSFields(append(dst, ' '), cssModel(hsva).(CSS), decimals)
+
+			return appendHSLAFields(append(dst, ' '), hsva.hsla(), decimals)
+		},
+		func(dst []byte) []byte {
+			return appendGoFields(dst, "colorx.HSVA", "H", "S", "V", "A", hsva.H, hsva.S, hsva.V, hsva.A)
+		})
+}
+
+// maxFormatDecimals limits the precision of Format to the decimals that a float64 can hold.
+const maxFormatDecimals = 15
+
+// formatColor writes the color c for the verb to s, padded to the width of s. text appends the function form and
+// fields the debug form with the decimals, which is the precision of s or defaultDecimals, and goSyntax appends the Go
+// syntax.
+func formatColor(s fmt.State, verb rune, c color.Color, defaultDecimals int,
+	text, fields func(dst []byte, decimals int) []byte, goSyntax func(dst []byte) []byte) {
+	var buf [192]byte
+	dst := buf[:0]
+
+	decimals, ok := s.Precision()
+	if !ok {
+		decimals = defaultDecimals
+	} else if decimals > maxFormatDecimals {
+		decimals = maxFormatDecimals
+	}
+
+	switch {
+	case verb == 'x' || verb == 'X':
+		f := defaultFormatter
+		f.upperHex = verb == 'X'
+		dst = f.AppendHex(dst, cssModel(c).(CSS))
+
+	case verb == 'v' && s.Flag('#'):
+		dst = goSyntax(dst)
+
+	case verb == 'v' && s.Flag('+'):
+		dst = fields(dst, decimals)
+
+	case verb == 's' || verb == 'v':
+		dst = text(dst, decimals)
+
+	case verb == 'q':
+		var unquoted [64]byte
+		dst = strconv.AppendQuote(dst, string(text(unquoted[:0], decimals)))
+
+	default:
+		// Like fmt, report the bad verb together with the type and value.
+		dst = append(dst, "%!"...)
+		dst = append(dst, string(verb)...)
+		dst = append(dst, '(')
+		dst = append(dst, fmt.Sprintf("%T", c)...)
+		dst = append(dst, '=')
+		dst = text(dst, defaultDecimals)
+		dst = append(dst, ')')
+	}
+
+	writePadded(s, dst)
+}
+
+// writePadded writes the text to s, padded with spaces to the width of s, on the right if the minus flag is set.
+func writePadded(s fmt.State, text []byte) {
+	width, ok := s.Width()
+	padding := width - utf8.RuneCount(text)
+
+	if ok && padding > 0 && !s.Flag('-') {
+		writeSpaces(s, padding)
+	}
+
+	_, _ = s.Write(text)
+
+	if ok && padding > 0 && s.Flag('-') {
+		writeSpaces(s, padding)
+	}
+}
+
+func writeSpaces(s fmt.State, n int) {
+	const spaces = "                                "
+
+	for n > 0 {
+		k := n
+		if k > len(spaces) {
+			k = len(spaces)
+		}
+		_, _ = s.Write([]byte(spaces[:k]))
+		n -= k
+	}
+}
+
+// appendCSSFields appends the fields of the color as "CSS{R:255 G:136 B:0 Opacity:0.5}".
+func appendCSSFields(dst []byte, c CSS, decimals int) []byte {
+	return appendFields(dst, "CSS", "R", "G", "B", "Opacity", float64(c.R), float64(c.G), float64(c.B), c.Opacity,
+		decimals)
+}
+
+// appendHSLAFields appends the fields of the color as "HSLA{H:32 S:1 L:0.5 A:0.5}".
+func appendHSLAFields(dst []byte, hsla HSLA, decimals int) []byte {
+	return appendFields(dst, "HSLA", "H", "S", "L", "A", hsla.H, hsla.S, hsla.L, hsla.A, decimals)
+}
+
+// appendHSVAFields appends the fields of the color as "HSVA{H:32 S:1 V:1 A:0.5}".
+func appendHSVAFields(dst []byte, hsva HSVA, decimals int) []byte {
+	return appendFields(dst, "HSVA", "H", "S", "V", "A", hsva.H, hsva.S, hsva.V, hsva.A, decimals)
+}
+
+// appendFields appends the name of the model followed by the keys and values of its fields in braces, with the values
+// rounded to the decimals. A missing value is written as NaN.
+func appendFields(dst []byte, name, k1, k2, k3, k4 string, v1, v2, v3, v4 float64, decimals int) []byte {
+	dst = append(dst, name...)
+
+	for i, kv := range [4]struct {
+		k string
+		v float64
+	}{{k1, v1}, {k2, v2}, {k3, v3}, {k4, v4}} {
+		if i == 0 {
+			dst = append(dst, '{')
+		} else {
+			dst = append(dst, ' ')
+		}
+		dst = append(dst, kv.k...)
+		dst = append(dst, ':')
+		dst = appendRounded(dst, kv.v, decimals)
+	}
+
+	return append(dst, '}')
+}
+
+// appendGoFields appends the Go syntax of a struct with four float64 fields, like %#v.
+func appendGoFields(dst []byte, name, k1, k2, k3, k4 string, v1, v2, v3, v4 float64) []byte {
+	dst = append(dst, name...)
+
+	for i, kv := range [4]struct {
+		k string
+		v float64
+	}{{k1, v1}, {k2, v2}, {k3, v3}, {k4, v4}} {
+		if i == 0 {
+			dst = append(dst, '{')
+		} else {
+			dst = append(dst, ", "...)
+		}
+		dst = append(dst, kv.k...)
+		dst = append(dst, ':')
+		dst = strconv.AppendFloat(dst, kv.v, 'g', -1, 64)
+	}
+
+	return append(dst, '}')
+}
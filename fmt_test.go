@@ -0,0 +1,79 @@
+package colorx
+
+import (
+	"fmt"
+	"math"
+	"testing"
+)
+
+func TestFormat(t *testing.T) {
+	css := CSS{R: 0xFF, G: 0x88, Opacity: 0.5}
+	hsla := HSLA{H: 200.5, S: 0.25, L: 0.4, A: 0.5}
+	hsva := HSVA{H: 120.0, S: 0.5, V: 1.0, A: 1.0}
+
+	tests := []struct {
+		name   string
+		format string
+		c      interface{}
+		want   string
+	}{
+		{name: "css_v", format: "%v", c: css, want: "rgba(255,136,0,0.5)"},
+		{name: "css_s", format: "%s", c: css, want: "rgba(255,136,0,0.5)"},
+		{name: "css_q", format: "%q", c: css, want: `"rgba(255,136,0,0.5)"`},
+		{name: "css_x", format: "%x", c: css, want: "#ff880080"},
+		{name: "css_X", format: "%X", c: css, want: "#FF880080"},
+		{name: "css_precision", format: "%.4s", c: CSS{R: 0xFF, Opacity: 0.12345}, want: "rgba(255,0,0,0.1235)"},
+		{name: "css_width", format: "%22v|", c: css, want: "   rgba(255,136,0,0.5)|"},
+		{name: "css_width_left", format: "%-22v|", c: css, want: "rgba(255,136,0,0.5)   |"},
+		{name: "css_width_hex", format: "%10x|", c: CSS{R: 0xFF, Opacity: 1.0}, want: "   #ff0000|"},
+		{
+			name:   "css_debug",
+			format: "%+v",
+			c:      css,
+			want:   "CSS{R:255 G:136 B:0 Opacity:0.5} HSLA{H:32 S:1 L:0.5 A:0.5} HSVA{H:32 S:1 V:1 A:0.5}",
+		},
+		{name: "css_go", format: "%#v", c: css, want: "colorx.CSS{R:0xff, G:0x88, B:0x0, Opacity:0.5}"},
+		{name: "css_bad_verb", format: "%d", c: css, want: "%!d(colorx.CSS=rgba(255,136,0,0.5))"},
+		{name: "css_pointer", format: "%x", c: &css, want: "#ff880080"},
+
+		{name: "hsla_v", format: "%v", c: hsla, want: "hsl(200.5 25% 40% / 0.5)"},
+		{name: "hsla_x", format: "%x", c: hsla, want: "#4d6e8080"},
+		{
+			name:   "hsla_precision",
+			format: "%.1s",
+			c:      HSLA{H: 200.25, S: 0.123456, L: 0.4, A: 0.5},
+			want:   "hsl(200.3 12.3% 40% / 0.5)",
+		},
+		{name: "hsla_missing", format: "%s", c: HSLA{H: math.NaN(), L: 0.5, A: 1.0}, want: "hsl(none 0% 50%)"},
+		{
+			name:   "hsla_debug",
+			format: "%+v",
+			c:      hsla,
+			want:   "HSLA{H:200.5 S:0.25 L:0.4 A:0.5} CSS{R:77 G:110 B:128 Opacity:0.5} HSVA{H:200.5 S:0.4 V:0.5 A:0.5}",
+		},
+		{
+			name:   "hsla_debug_precision",
+			format: "%+.1v",
+			c:      hsla,
+			want:   "HSLA{H:200.5 S:0.3 L:0.4 A:0.5} CSS{R:77 G:110 B:128 Opacity:0.5} HSVA{H:200.5 S:0.4 V:0.5 A:0.5}",
+		},
+		{name: "hsla_go", format: "%#v", c: hsla, want: "colorx.HSLA{H:200.5, S:0.25, L:0.4, A:0.5}"},
+
+		{name: "hsva_v", format: "%v", c: hsva, want: "hsl(120 100% 75%)"},
+		{name: "hsva_X", format: "%X", c: hsva, want: "#80FF80"},
+		{
+			name:   "hsva_debug",
+			format: "%+v",
+			c:      hsva,
+			want:   "HSVA{H:120 S:0.5 V:1 A:1} CSS{R:128 G:255 B:128 Opacity:1} HSLA{H:120 S:1 L:0.75 A:1}",
+		},
+		{name: "hsva_go", format: "%#v", c: hsva, want: "colorx.HSVA{H:120, S:0.5, V:1, A:1}"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := fmt.Sprintf(tt.format, tt.c); got != tt.want {
+				t.Errorf("Sprintf(%q) = %v, want %v", tt.format, got, tt.want)
+			}
+		})
+	}
+}
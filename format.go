@@ -0,0 +1,307 @@
+package colorx
+
+import (
+	"math"
+	"strconv"
+
+	"github.com/somebadcode/go-colorx/v2/internal/mathx"
+)
+
+// Formatter writes CSS colors as text, with the syntax, precision and casing chosen by its options. Create one with
+// NewFormatter.
+type Formatter struct {
+	modern       bool
+	alphaPercent bool
+	precision    int
+	upperHex     bool
+	shortHex     bool
+	shortest     bool
+	colorSpace   bool
+	space        Space
+}
+
+// FormatOption configures a Formatter.
+type FormatOption func(*Formatter)
+
+// defaultPrecision is the number of decimals of the alpha, the same as CSS.String.
+const defaultPrecision = 2
+
+// defaultFormatter formats CSS.String and CSS.HexString. It is shared since NewFormatter allocates to apply options.
+var defaultFormatter = NewFormatter()
+
+// NewFormatter returns a Formatter that writes the legacy comma separated rgb() and rgba() functions with the alpha
+// rounded to two decimals, like CSS.String, unless options say otherwise.
+func NewFormatter(opts ...FormatOption) Formatter {
+	f := Formatter{precision: defaultPrecision}
+	for _, opt := range opts {
+		opt(&f)
+	}
+
+	return f
+}
+
+// ModernSyntax makes the Formatter write the space separated syntax of CSS Color Level 4, "rgb(255 128 0 / 0.5)",
+// instead of "rgba(255,128,0,0.5)".
+func ModernSyntax() FormatOption {
+	return func(f *Formatter) {
+		f.modern = true
+	}
+}
+
+// AlphaPercentage makes the Formatter write the alpha as a percentage, such as "50%".
+func AlphaPercentage() FormatOption {
+	return func(f *Formatter) {
+		f.alphaPercent = true
+	}
+}
+
+// WithPrecision sets the number of decimals that the alpha is rounded to, the default is two. Trailing zeros are left
+// out. Negative precisions are zero.
+func WithPrecision(decimals int) FormatOption {
+	return func(f *Formatter) {
+		f.precision = decimals
+		if decimals < 0 {
+			f.precision = 0
+		}
+	}
+}
+
+// UpperCaseHex makes the Formatter write hexadecimal colors in upper case, such as "#FFCC00".
+func UpperCaseHex() FormatOption {
+	return func(f *Formatter) {
+		f.upperHex = true
+	}
+}
+
+// ShortHex makes the Formatter collapse hexadecimal colors where every channel has two equal digits, so that "#ffcc00"
+// is written as "#fc0".
+func ShortHex() FormatOption {
+	return func(f *Formatter) {
+		f.shortHex = true
+	}
+}
+
+// Shortest makes Format write the shortest of the function, the hexadecimal notation and the name of the color, for
+// minifying CSS. The hexadecimal notation is collapsed when possible, and it is only used for a translucent color if
+// its 8-bit alpha rounds to the same alpha at the precision of the Formatter.
+func Shortest() FormatOption {
+	return func(f *Formatter) {
+		f.shortest = true
+	}
+}
+
+// ColorSpace makes the Formatter write the CSS color() function in the color space instead of rgb(), such as
+// "color(display-p3 0.9175 0.2003 0.1386)" for red, with the channels rounded to four decimals. Spaces that color()
+// does not take, such as SpaceHSL, are ignored. Use Hex as a fallback for browsers without color().
+func ColorSpace(space Space) FormatOption {
+	return func(f *Formatter) {
+		_, f.colorSpace = colorSpaces[space.String()]
+		f.space = space
+	}
+}
+
+// Format returns the color as the rgb() or rgba() function, the color() function if the Formatter has the ColorSpace
+// option, or as the shortest representation if the Formatter has
+// the Shortest option.
+func (f Formatter) Format(c CSS) string {
+	var buf [32]byte
+
+	return string(f.AppendFormat(buf[:0], c))
+}
+
+// AppendFormat appends the color, formatted like Format, to dst and returns the extended buffer. It does not allocate
+// if dst has room for the text.
+func (f Formatter) AppendFormat(dst []byte, c CSS) []byte {
+	if !f.shortest {
+		return f.appendFunction(dst, c)
+	}
+
+	start := len(dst)
+	dst = f.appendFunction(dst, c)
+
+	if f.exactHexAlpha(c) {
+		short := f
+		short.shortHex = true
+		if short.hexLen(c) < len(dst)-start {
+			dst = short.AppendHex(dst[:start], c)
+		}
+	}
+
+	if name, ok := c.Name(); ok && len(name) < len(dst)-start {
+		dst = append(dst[:start], name...)
+	}
+
+	return dst
+}
+
+// Hex returns the color in the hexadecimal notation, with the alpha left out if the color is opaque.
+func (f Formatter) Hex(c CSS) string {
+	var buf [9]byte
+
+	return string(f.AppendHex(buf[:0], c))
+}
+
+// AppendHex appends the color in the hexadecimal notation, like Hex, to dst and returns the extended buffer. It does
+// not allocate if dst has room for the text.
+func (f Formatter) AppendHex(dst []byte, c CSS) []byte {
+	digits := hexDigitsLower
+	if f.upperHex {
+		digits = hexDigitsUpper
+	}
+
+	channels, n, short := f.hexChannels(c)
+
+	dst = append(dst, '#')
+	for _, v := range channels[:n] {
+		if !short {
+			dst = append(dst, digits[v>>4])
+		}
+		dst = append(dst, digits[v&0x0F])
+	}
+
+	return dst
+}
+
+// hexChannels returns the channels of the hexadecimal notation of the color, the number of channels and whether they
+// are collapsed to a single digit each.
+func (f Formatter) hexChannels(c CSS) (channels [4]uint8, n int, short bool) {
+	channels = [4]uint8{c.R, c.G, c.B, opacityUint8(c.SanitizedOpacity())}
+
+	n = 3
+	if c.SanitizedOpacity() < 1.0 {
+		n = 4
+	}
+
+	short = f.shortHex
+	for _, v := range channels[:n] {
+		short = short && v>>4 == v&0x0F
+	}
+
+	return channels, n, short
+}
+
+// hexLen returns the length of the hexadecimal notation of the color.
+func (f Formatter) hexLen(c CSS) int {
+	_, n, short := f.hexChannels(c)
+	if short {
+		return 1 + n
+	}
+
+	return 1 + 2*n
+}
+
+// appendFunction appends the color as the rgb() or rgba() function, or as the color() function in the color space of
+// the Formatter.
+func (f Formatter) appendFunction(dst []byte, c CSS) []byte {
+	if f.colorSpace {
+		return f.appendColorFunction(dst, c)
+	}
+
+	opacity := c.SanitizedOpacity()
+	opaque := opacity >= 1.0
+
+	switch {
+	case f.modern || opaque:
+		dst = append(dst, "rgb("...)
+	default:
+		dst = append(dst, "rgba("...)
+	}
+
+	separator := byte(',')
+	if f.modern {
+		separator = ' '
+	}
+
+	dst = strconv.AppendUint(dst, uint64(c.R), 10)
+	dst = append(dst, separator)
+	dst = strconv.AppendUint(dst, uint64(c.G), 10)
+	dst = append(dst, separator)
+	dst = strconv.AppendUint(dst, uint64(c.B), 10)
+
+	if !opaque {
+		if f.modern {
+			dst = append(dst, " / "...)
+		} else {
+			dst = append(dst, ',')
+		}
+
+		dst = f.appendOpacity(dst, opacity)
+	}
+
+	return append(dst, ')')
+}
+
+// appendColorFunction appends the color converted to the color space of the Formatter as the color() function, in the
+// modern syntax.
+func (f Formatter) appendColorFunction(dst []byte, c CSS) []byte {
+	v, _ := convertComponents(c, f.space)
+
+	dst = append(dst, "color("...)
+	dst = append(dst, f.space.String()...)
+	for _, x := range v {
+		dst = append(dst, ' ')
+		dst = appendRounded(dst, x, cssDecimals)
+	}
+
+	if opacity := c.SanitizedOpacity(); opacity < 1.0 {
+		dst = append(dst, " / "...)
+		dst = f.appendOpacity(dst, opacity)
+	}
+
+	return append(dst, ')')
+}
+
+// appendOpacity appends the opacity, rounded half away from zero to the precision of the Formatter, as a number or a
+// percentage. An opacity between zero and one is kept at least one step of the precision away from both, so that a
+// translucent color is not written as transparent or opaque.
+func (f Formatter) appendOpacity(dst []byte, opacity float64) []byte {
+	full := 1.0
+	if f.alphaPercent {
+		full = 100.0
+	}
+
+	v := opacity * full
+	if step := math.Pow10(-f.precision); opacity > 0 && opacity < 1 && 2*step < full {
+		v = mathx.Clamp(v, step, full-step)
+	}
+
+	dst = appendRounded(dst, v, f.precision)
+	if f.alphaPercent {
+		dst = append(dst, '%')
+	}
+
+	return dst
+}
+
+// exactHexAlpha reports whether the 8-bit alpha of the hexadecimal notation rounds to the same alpha as the color at
+// the precision of the Formatter.
+func (f Formatter) exactHexAlpha(c CSS) bool {
+	opacity := c.SanitizedOpacity()
+	if opacity >= 1.0 {
+		return true
+	}
+
+	scale := math.Pow10(f.precision)
+	if f.alphaPercent {
+		scale *= 100
+	}
+
+	return math.Round(opacity*scale) == math.Round(float64(opacityUint8(opacity))/math.MaxUint8*scale)
+}
+
+const (
+	hexDigitsLower = "0123456789abcdef"
+	hexDigitsUpper = "0123456789ABCDEF"
+)
+
+// appendRounded appends the number rounded to the decimals, without trailing zeros.
+func appendRounded(dst []byte, f float64, decimals int) []byte {
+	scale := math.Pow10(decimals)
+	f = math.Round(f*scale) / scale
+	if f == 0 {
+		// Avoid "-0".
+		f = 0
+	}
+
+	return strconv.AppendFloat(dst, f, 'f', -1, 64)
+}
@@ -0,0 +1,245 @@
+package colorx
+
+import (
+	"testing"
+)
+
+func TestFormatter_Format(t *testing.T) {
+	gold := CSS{R: 0xFF, G: 0xCC, Opacity: 1.0}
+	translucent := CSS{R: 0xFF, G: 0xCC, Opacity: 0.5}
+	navy := CSS{B: 0x80, Opacity: 0.5}
+
+	tests := []struct {
+		name string
+		opts []FormatOption
+		c    CSS
+		want string
+	}{
+		{
+			name: "default",
+			c:    gold,
+			want: "rgb(255,204,0)",
+		},
+		{
+			name: "default_alpha",
+			c:    translucent,
+			want: "rgba(255,204,0,0.5)",
+		},
+		{
+			name: "default_trailing_zeros",
+			c:    CSS{R: 0xFF, G: 0xCC, Opacity: 0.1},
+			want: "rgba(255,204,0,0.1)",
+		},
+		{
+			name: "modern",
+			opts: []FormatOption{ModernSyntax()},
+			c:    gold,
+			want: "rgb(255 204 0)",
+		},
+		{
+			name: "modern_alpha",
+			opts: []FormatOption{ModernSyntax()},
+			c:    translucent,
+			want: "rgb(255 204 0 / 0.5)",
+		},
+		{
+			name: "modern_alpha_percentage",
+			opts: []FormatOption{ModernSyntax(), AlphaPercentage()},
+			c:    translucent,
+			want: "rgb(255 204 0 / 50%)",
+		},
+		{
+			name: "legacy_alpha_percentage",
+			opts: []FormatOption{AlphaPercentage(), WithPrecision(1)},
+			c:    CSS{R: 0xFF, G: 0xCC, Opacity: 0.125},
+			want: "rgba(255,204,0,12.5%)",
+		},
+		{
+			name: "precision",
+			opts: []FormatOption{WithPrecision(3)},
+			c:    CSS{R: 0xFF, G: 0xCC, Opacity: 0.12345},
+			want: "rgba(255,204,0,0.123)",
+		},
+		{
+			name: "percentage_almost_transparent",
+			opts: []FormatOption{ModernSyntax(), AlphaPercentage(), WithPrecision(0)},
+			c:    CSS{R: 0xFF, G: 0xCC, Opacity: 0.001},
+			want: "rgb(255 204 0 / 1%)",
+		},
+		{
+			name: "precision_negative",
+			opts: []FormatOption{WithPrecision(-1)},
+			c:    CSS{R: 0xFF, G: 0xCC, Opacity: 0.25},
+			want: "rgba(255,204,0,0)",
+		},
+		{
+			name: "shortest_name",
+			opts: []FormatOption{Shortest()},
+			c:    CSS{R: 0xFF, Opacity: 1.0},
+			want: "red",
+		},
+		{
+			name: "shortest_short_hex",
+			opts: []FormatOption{Shortest()},
+			c:    CSS{R: 0x66, G: 0x33, B: 0x99, Opacity: 1.0},
+			want: "#639",
+		},
+		{
+			name: "shortest_hex",
+			opts: []FormatOption{Shortest(), UpperCaseHex()},
+			c:    CSS{R: 0xFF, G: 0xBF, B: 0x80, Opacity: 1.0},
+			want: "#FFBF80",
+		},
+		{
+			name: "shortest_transparent",
+			opts: []FormatOption{Shortest()},
+			c:    CSS{},
+			want: "#0000",
+		},
+		{
+			name: "shortest_hex_alpha",
+			opts: []FormatOption{Shortest()},
+			c:    navy,
+			want: "#00008080",
+		},
+		{
+			name: "shortest_inexact_hex_alpha",
+			opts: []FormatOption{Shortest(), WithPrecision(4)},
+			c:    navy,
+			want: "rgba(0,0,128,0.5)",
+		},
+		{
+			name: "color_space",
+			opts: []FormatOption{ColorSpace(SpaceDisplayP3)},
+			c:    CSS{R: 0xFF, Opacity: 1.0},
+			want: "color(display-p3 0.9175 0.2003 0.1386)",
+		},
+		{
+			name: "color_space_alpha_percentage",
+			opts: []FormatOption{ColorSpace(SpaceSRGB), AlphaPercentage()},
+			c:    translucent,
+			want: "color(srgb 1 0.8 0 / 50%)",
+		},
+		{
+			name: "color_space_not_rgb",
+			opts: []FormatOption{ColorSpace(SpaceHSL)},
+			c:    gold,
+			want: "rgb(255,204,0)",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := NewFormatter(tt.opts...).Format(tt.c); got != tt.want {
+				t.Errorf("Format() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestFormatter_Hex(t *testing.T) {
+	tests := []struct {
+		name string
+		opts []FormatOption
+		c    CSS
+		want string
+	}{
+		{
+			name: "default",
+			c:    CSS{R: 0xFF, G: 0xCC, Opacity: 1.0},
+			want: "#ffcc00",
+		},
+		{
+			name: "upper",
+			opts: []FormatOption{UpperCaseHex()},
+			c:    CSS{R: 0xFF, G: 0xCC, Opacity: 1.0},
+			want: "#FFCC00",
+		},
+		{
+			name: "short",
+			opts: []FormatOption{ShortHex()},
+			c:    CSS{R: 0xFF, G: 0xCC, Opacity: 1.0},
+			want: "#fc0",
+		},
+		{
+			name: "short_alpha",
+			opts: []FormatOption{ShortHex(), UpperCaseHex()},
+			c:    CSS{R: 0xFF, G: 0xCC, Opacity: float64(0x88) / 0xFF},
+			want: "#FC08",
+		},
+		{
+			name: "short_not_collapsible",
+			opts: []FormatOption{ShortHex()},
+			c:    CSS{R: 0xFF, G: 0xBF, B: 0x80, Opacity: 1.0},
+			want: "#ffbf80",
+		},
+		{
+			name: "short_alpha_not_collapsible",
+			opts: []FormatOption{ShortHex()},
+			c:    CSS{R: 0xFF, G: 0xCC, Opacity: 0.5},
+			want: "#ffcc0080",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := NewFormatter(tt.opts...).Hex(tt.c); got != tt.want {
+				t.Errorf("Hex() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestFormatter_ColorSpace_fallback(t *testing.T) {
+	c := CSS{R: 0xFF, G: 0xCC, Opacity: 0.5}
+	f := NewFormatter(ColorSpace(SpaceDisplayP3))
+
+	if got, want := f.Format(c), "color(display-p3 0.9684 0.8077 0.2735 / 0.5)"; got != want {
+		t.Errorf("Format() = %v, want %v", got, want)
+	}
+	if got, want := f.Hex(c), c.HexString(); got != want {
+		t.Errorf("Hex() = %v, want %v", got, want)
+	}
+}
+
+func TestCSS_AppendText(t *testing.T) {
+	c := CSS{R: 0xFF, G: 0xBF, B: 0x80, Opacity: 0.75}
+
+	if got := string(c.AppendText([]byte("color: "))); got != "color: rgba(255,191,128,0.75)" {
+		t.Errorf("AppendText() = %v", got)
+	}
+	if got := string(c.AppendHex([]byte("color: "))); got != "color: #ffbf80bf" {
+		t.Errorf("AppendHex() = %v", got)
+	}
+}
+
+func TestAppend_allocs(t *testing.T) {
+	c := CSS{R: 0xFF, G: 0xBF, B: 0x80, Opacity: 0.75}
+	shortest := NewFormatter(Shortest(), ModernSyntax(), AlphaPercentage())
+	buf := make([]byte, 0, 64)
+
+	tests := []struct {
+		name string
+		fn   func()
+	}{
+		{name: "CSS.AppendText", fn: func() { buf = c.AppendText(buf[:0]) }},
+		{name: "CSS.AppendHex", fn: func() { buf = c.AppendHex(buf[:0]) }},
+		{name: "Formatter.AppendFormat", fn: func() { buf = shortest.AppendFormat(buf[:0], c) }},
+		{name: "HSLA.AppendText", fn: func() { buf = HSLA{H: 200.5, S: 0.5, L: 0.25, A: 0.5}.AppendText(buf[:0]) }},
+		{name: "HSVA.AppendText", fn: func() { buf = HSVA{H: 200.5, S: 0.5, V: 0.25, A: 0.5}.AppendText(buf[:0]) }},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if allocs := testing.AllocsPerRun(100, tt.fn); allocs != 0 {
+				t.Errorf("%s allocates %v times, want 0", tt.name, allocs)
+			}
+		})
+	}
+}
+
+func BenchmarkFormatter_Format(b *testing.B) {
+	f := NewFormatter(Shortest())
+	c := CSS{R: 0x66, G: 0x33, B: 0x99, Opacity: 0.5}
+
+	for n := 0; n < b.N; n++ {
+		_ = f.Format(c)
+	}
+}
@@ -0,0 +1,234 @@
+package colorx
+
+import (
+	"image/color"
+	"math"
+
+	"github.com/somebadcode/go-colorx/v2/internal/mathx"
+)
+
+// GamutMethod selects how ToGamut brings a color that is outside of a gamut inside of it.
+type GamutMethod int
+
+const (
+	// GamutCSS is the gamut mapping algorithm of CSS Color 4. It reduces the chroma in OKLCh, keeping lightness and
+	// hue, until the color clipped to the gamut is no more than a just noticeable difference (ΔEOK 0.02) away.
+	GamutCSS GamutMethod = iota
+
+	// GamutClip clips each channel to the gamut. It is fast, but can shift the hue and lightness a lot.
+	GamutClip
+
+	// GamutScale moves the color towards the gray of the same luminance in linear light until it is inside of the
+	// gamut. It keeps the luminance, unless that is outside of the gamut itself.
+	GamutScale
+)
+
+// Constants of the CSS Color 4 gamut mapping algorithm.
+const (
+	gamutJND     = 0.02   // Just noticeable difference in ΔEOK
+	gamutEpsilon = 0.0001 // Precision of the chroma search
+)
+
+// gamutTolerance is how far outside of a gamut a channel may be because of rounding errors.
+const gamutTolerance = 1e-6
+
+// gamut is the RGB gamut of a color space, as the conversions between linear-light sRGB and the linear-light RGB of
+// the space.
+type gamut struct {
+	fromLinearSRGB mathx.Matrix3
+	toLinearSRGB   mathx.Matrix3
+}
+
+var identity = mathx.Diagonal(mathx.Vector3{1, 1, 1})
+
+var srgbGamut = gamut{fromLinearSRGB: identity, toLinearSRGB: identity}
+
+// gamut returns the RGB gamut of the space, and false if the space is not bounded by one.
+func (s Space) gamut() (gamut, bool) {
+	switch s {
+	case SpaceSRGB, SpaceLinearSRGB, SpaceHSL, SpaceHSV, SpaceHWB:
+		return srgbGamut, true
+
+	case SpaceDisplayP3:
+		return displayP3.gamut, true
+
+	case SpaceRec2020:
+		return rec2020.gamut, true
+
+	case SpaceA98RGB:
+		return a98RGB.gamut, true
+
+	case SpaceProPhotoRGB:
+		return proPhotoRGB.gamut, true
+
+	default:
+		return gamut{}, false
+	}
+}
+
+// rgb converts linear-light sRGB to the linear-light RGB of the gamut.
+func (gm gamut) rgb(r, g, b float64) mathx.Vector3 {
+	return gm.fromLinearSRGB.MulVec(mathx.Vector3{r, g, b})
+}
+
+// contains reports whether linear-light RGB of the gamut is inside of it.
+func (gm gamut) contains(rgb mathx.Vector3) bool {
+	for _, v := range rgb {
+		if v < -gamutTolerance || v > 1+gamutTolerance {
+			return false
+		}
+	}
+
+	return true
+}
+
+// clip clips linear-light RGB of the gamut to it and returns the result as linear-light sRGB.
+func (gm gamut) clip(rgb mathx.Vector3) mathx.Vector3 {
+	for i, v := range rgb {
+		rgb[i] = mathx.Clamp(v, 0, 1)
+	}
+
+	return gm.toLinearSRGB.MulVec(rgb)
+}
+
+// InGamut reports whether the color is inside of the gamut of the space, allowing for rounding errors. SpaceSRGB,
+// SpaceLinearSRGB, SpaceHSL, SpaceHSV and SpaceHWB share the sRGB gamut, the other RGB spaces have gamuts of their own.
+// The CIE and OK spaces have no gamut, so every color is inside of them.
+func InGamut(c color.Color, space Space) bool {
+	gm, ok := space.gamut()
+	if !ok {
+		return true
+	}
+
+	r, g, b, _ := toLinearSRGB(c)
+
+	return gm.contains(gm.rgb(r, g, b))
+}
+
+// ToGamut returns the color brought inside of the gamut of the space with the method, as the type of the space. A color
+// that is already inside of the gamut is only converted.
+func ToGamut(c color.Color, space Space, method GamutMethod) color.Color {
+	gm, ok := space.gamut()
+	if !ok {
+		return convertSpace(c, space)
+	}
+
+	var rgb mathx.Vector3
+	var alpha float64
+
+	switch method {
+	case GamutClip:
+		var r, g, b float64
+		r, g, b, alpha = toLinearSRGB(c)
+		rgb = gm.clip(gm.rgb(r, g, b))
+
+	case GamutScale:
+		rgb, alpha = gm.scale(c)
+
+	default: // GamutCSS
+		rgb, alpha = gm.mapCSS(c)
+	}
+
+	return convertSpace(LinearSRGB{R: rgb[0], G: rgb[1], B: rgb[2], A: alpha}, space)
+}
+
+// mapCSS maps the color to the gamut with the CSS Color 4 algorithm and returns it as linear-light sRGB.
+func (gm gamut) mapCSS(c color.Color) (mathx.Vector3, float64) {
+	origin := okLChFromOKLab(convertOKLab(c))
+
+	switch {
+	case origin.L >= 1.0:
+		return mathx.Vector3{1, 1, 1}, origin.Alpha
+
+	case origin.L <= 0.0:
+		return mathx.Vector3{}, origin.Alpha
+	}
+
+	current := origin
+	rgb := gm.rgbOf(current)
+	if gm.contains(rgb) {
+		return gm.toLinearSRGB.MulVec(rgb), origin.Alpha
+	}
+
+	clipped := gm.clip(rgb)
+	if distanceOK(clipped, current.okLab()) < gamutJND {
+		return clipped, origin.Alpha
+	}
+
+	lo, hi := 0.0, current.C
+	loInGamut := true
+
+	for hi-lo > gamutEpsilon {
+		current.C = (lo + hi) / 2
+		rgb = gm.rgbOf(current)
+
+		if loInGamut && gm.contains(rgb) {
+			lo = current.C
+			continue
+		}
+
+		clipped = gm.clip(rgb)
+		e := distanceOK(clipped, current.okLab())
+
+		if e >= gamutJND {
+			hi = current.C
+			continue
+		}
+
+		if gamutJND-e < gamutEpsilon {
+			break
+		}
+
+		loInGamut = false
+		lo = current.C
+	}
+
+	return clipped, origin.Alpha
+}
+
+// scale moves the color towards the gray of the same luminance until it is inside of the gamut and returns it as
+// linear-light sRGB.
+func (gm gamut) scale(c color.Color) (mathx.Vector3, float64) {
+	r, g, b, alpha := toLinearSRGB(c)
+	rgb := gm.rgb(r, g, b)
+
+	if gm.contains(rgb) {
+		return mathx.Vector3{r, g, b}, alpha
+	}
+
+	// The luminance of the gray is that of the color, clipped to the gamut.
+	y := mathx.Clamp(linearSRGBToXYZ[1][0]*r+linearSRGBToXYZ[1][1]*g+linearSRGBToXYZ[1][2]*b, 0, 1)
+	gray := gm.rgb(y, y, y)
+
+	// Find the largest fraction of the way from the gray to the color that is inside of the gamut.
+	t := 1.0
+	for i, v := range rgb {
+		switch {
+		case v > 1:
+			t = math.Min(t, (1-gray[i])/(v-gray[i]))
+
+		case v < 0:
+			t = math.Min(t, gray[i]/(gray[i]-v))
+		}
+	}
+
+	for i := range rgb {
+		rgb[i] = gray[i] + (rgb[i]-gray[i])*t
+	}
+
+	return gm.clip(rgb), alpha
+}
+
+// rgbOf returns the color as linear-light RGB of the gamut.
+func (gm gamut) rgbOf(c linearer) mathx.Vector3 {
+	r, g, b, _ := c.linear()
+
+	return gm.rgb(r, g, b)
+}
+
+// distanceOK returns ΔEOK between linear-light sRGB and an OKLab color.
+func distanceOK(rgb mathx.Vector3, lab OKLab) float64 {
+	other := okLabFromLinear(rgb[0], rgb[1], rgb[2], lab.Alpha)
+
+	return math.Sqrt(sq(other.L-lab.L) + sq(other.A-lab.A) + sq(other.B-lab.B))
+}
@@ -0,0 +1,169 @@
+package colorx
+
+import (
+	"image/color"
+	"math"
+	"testing"
+
+	"github.com/somebadcode/go-colorx/v2/internal/mathx"
+)
+
+func TestInGamut(t *testing.T) {
+	type args struct {
+		c     color.Color
+		space Space
+	}
+	tests := []struct {
+		name string
+		args args
+		want bool
+	}{
+		{
+			name: "rgba",
+			args: args{c: color.RGBA{R: 0xFF, A: 0xFF}, space: SpaceSRGB},
+			want: true,
+		},
+		{
+			name: "oklch_in",
+			args: args{c: OKLCh{L: 0.7, C: 0.1, H: 150.0, Alpha: 1.0}, space: SpaceSRGB},
+			want: true,
+		},
+		{
+			name: "oklch_out",
+			args: args{c: OKLCh{L: 0.7, C: 0.3, H: 150.0, Alpha: 1.0}, space: SpaceSRGB},
+		},
+		{
+			name: "hsla_oversaturated",
+			args: args{c: HSLA{S: 1.5, L: 0.5, A: 1.0}, space: SpaceHSL},
+		},
+		{
+			name: "linear_srgb_rounding",
+			args: args{c: LinearSRGB{R: 1.0 + 1e-9, B: -1e-9, A: 1.0}, space: SpaceLinearSRGB},
+			want: true,
+		},
+		{
+			name: "unbounded",
+			args: args{c: OKLCh{L: 0.7, C: 0.3, H: 150.0, Alpha: 1.0}, space: SpaceOKLab},
+			want: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := InGamut(tt.args.c, tt.args.space); got != tt.want {
+				t.Errorf("InGamut() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestToGamut(t *testing.T) {
+	type args struct {
+		c      color.Color
+		space  Space
+		method GamutMethod
+	}
+	tests := []struct {
+		name string
+		args args
+		want color.Color
+	}{
+		{
+			name: "in_gamut",
+			args: args{c: CSS{R: 0x80, G: 0x40, B: 0x20, Opacity: 0.5}, space: SpaceSRGB},
+			want: SRGB{R: 0x80 / 255.0, G: 0x40 / 255.0, B: 0x20 / 255.0, A: 0.5},
+		},
+		{
+			name: "css_too_light",
+			args: args{c: OKLCh{L: 1.1, C: 0.2, H: 40.0, Alpha: 1.0}, space: SpaceSRGB},
+			want: SRGB{R: 1.0, G: 1.0, B: 1.0, A: 1.0},
+		},
+		{
+			name: "css_too_dark",
+			args: args{c: OKLab{L: -0.1, A: 0.1, Alpha: 0.5}, space: SpaceHSL},
+			want: HSLA{A: 0.5},
+		},
+		{
+			name: "clip",
+			args: args{c: LinearSRGB{R: 1.5, G: -0.2, B: 0.5, A: 1.0}, space: SpaceLinearSRGB, method: GamutClip},
+			want: LinearSRGB{R: 1.0, B: 0.5, A: 1.0},
+		},
+		{
+			name: "clip_hsl",
+			args: args{c: HSLA{H: 120.0, S: 1.5, L: 0.5, A: 1.0}, space: SpaceHSL, method: GamutClip},
+			want: HSLA{H: 120.0, S: 1.0, L: 0.5, A: 1.0},
+		},
+		{
+			name: "scale",
+			args: args{c: LinearSRGB{R: 1.2, G: 0.5, B: 0.5, A: 1.0}, space: SpaceLinearSRGB, method: GamutScale},
+			want: LinearSRGB{R: 1.0, G: 0.55401, B: 0.55401, A: 1.0},
+		},
+		{
+			name: "unbounded",
+			args: args{c: CSS{R: 0xFF, Opacity: 1.0}, space: SpaceOKLab},
+			want: OKLab{L: 0.62796, A: 0.22486, B: 0.12585, Alpha: 1.0},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := ToGamut(tt.args.c, tt.args.space, tt.args.method)
+
+			gotV, wantV := mixComponents(got), mixComponents(tt.want)
+			if len(gotV) != len(wantV) {
+				t.Fatalf("ToGamut() = %T, want %T", got, tt.want)
+			}
+
+			for i := range gotV {
+				if !mathx.EqualP(gotV[i], wantV[i], 1e-4) {
+					t.Errorf("ToGamut() = %+v, want %+v", got, tt.want)
+					break
+				}
+			}
+		})
+	}
+}
+
+func TestToGamut_css(t *testing.T) {
+	colors := []OKLCh{
+		{L: 0.7, C: 0.4, H: 150.0, Alpha: 1.0},
+		{L: 0.5, C: 0.35, H: 265.0, Alpha: 1.0},
+		{L: 0.95, C: 0.3, H: 100.0, Alpha: 0.5},
+		{L: 0.2, C: 0.5, H: 10.0, Alpha: 1.0},
+	}
+	for _, c := range colors {
+		got := ToGamut(c, SpaceSRGB, GamutCSS).(SRGB)
+		if !InGamut(got, SpaceSRGB) {
+			t.Errorf("ToGamut(%+v) = %+v, not in gamut", c, got)
+		}
+
+		// The lightness and hue are kept, except for the final clip which is less than a just noticeable difference.
+		lch := okLChFromOKLab(convertOKLab(got))
+		if math.Abs(lch.L-c.L) > gamutJND || lch.C > c.C {
+			t.Errorf("ToGamut(%+v) = %+v, which is %+v", c, got, lch)
+		}
+
+		if got.A != c.Alpha {
+			t.Errorf("ToGamut(%+v) alpha = %v, want %v", c, got.A, c.Alpha)
+		}
+	}
+}
+
+func TestToGamut_scale(t *testing.T) {
+	c := OKLCh{L: 0.7, C: 0.4, H: 150.0, Alpha: 1.0}
+
+	got := ToGamut(c, SpaceSRGB, GamutScale)
+	if !InGamut(got, SpaceSRGB) {
+		t.Errorf("ToGamut() = %+v, not in gamut", got)
+	}
+
+	if gotY, wantY := convertXYZ(got, D65).Y, convertXYZ(c, D65).Y; !mathx.EqualP(gotY, wantY, 1e-9) {
+		t.Errorf("ToGamut() luminance = %v, want %v", gotY, wantY)
+	}
+}
+
+func BenchmarkToGamut(b *testing.B) {
+	c := OKLCh{L: 0.7, C: 0.4, H: 150.0, Alpha: 1.0}
+
+	for n := 0; n < b.N; n++ {
+		ToGamut(c, SpaceSRGB, GamutCSS)
+	}
+}
@@ -0,0 +1,134 @@
+package colorx
+
+import (
+	"image/color"
+	"image/draw"
+	"math"
+)
+
+// ColorStop is a color at a position along a gradient.
+type ColorStop struct {
+	Color    color.Color
+	Position float64 // Position along the gradient, 0 is the start and 1 the end
+
+	// Hint moves the midpoint of the transition to the next stop, as a fraction of the distance to it. Zero means no
+	// hint, which is the same as a hint of 0.5.
+	Hint float64
+}
+
+// EvenStops returns color stops for the colors, spread evenly from 0 to 1.
+func EvenStops(colors ...color.Color) []ColorStop {
+	stops := make([]ColorStop, len(colors))
+	for i, c := range colors {
+		stops[i] = ColorStop{Color: c}
+		if len(colors) > 1 {
+			stops[i].Position = float64(i) / float64(len(colors)-1)
+		}
+	}
+
+	return stops
+}
+
+// Gradient is a multi-stop color gradient, like the gradients of CSS. The colors between two stops are interpolated
+// with Mix.
+type Gradient struct {
+	stops []ColorStop
+	opts  []MixOption
+}
+
+// NewGradient returns a gradient through the color stops, interpolated with the options of Mix. A stop positioned
+// before a previous stop is moved to the position of the previous stop, which makes a hard transition between them.
+func NewGradient(stops []ColorStop, opts ...MixOption) Gradient {
+	g := Gradient{
+		stops: make([]ColorStop, len(stops)),
+		opts:  opts,
+	}
+
+	copy(g.stops, stops)
+
+	for i := 1; i < len(g.stops); i++ {
+		g.stops[i].Position = math.Max(g.stops[i].Position, g.stops[i-1].Position)
+	}
+
+	return g
+}
+
+// At returns the color of the gradient at t. Before the first stop it is the color of the first stop, after the last
+// stop the color of the last stop. A gradient without stops is transparent.
+func (g Gradient) At(t float64) color.Color {
+	if len(g.stops) == 0 {
+		return color.Transparent
+	}
+
+	// Find the first stop after t.
+	i := 0
+	for i < len(g.stops) && g.stops[i].Position <= t {
+		i++
+	}
+
+	switch i {
+	case 0:
+		return g.stops[0].Color
+
+	case len(g.stops):
+		return g.stops[i-1].Color
+	}
+
+	from, to := g.stops[i-1], g.stops[i]
+	w := (t - from.Position) / (to.Position - from.Position)
+
+	if from.Hint > 0 && from.Hint < 1 && from.Hint != 0.5 {
+		w = math.Pow(w, math.Log(0.5)/math.Log(from.Hint))
+	}
+
+	return Mix(from.Color, to.Color, w, g.opts...)
+}
+
+// DrawLinear draws the gradient along the line from (x0, y0) to (x1, y1) into dst, replacing the pixels within its
+// bounds. The lines perpendicular to the gradient line have the same color.
+func (g Gradient) DrawLinear(dst draw.Image, x0, y0, x1, y1 float64) {
+	dx, dy := x1-x0, y1-y0
+	length2 := dx*dx + dy*dy
+
+	g.draw(dst, func(x, y float64) float64 {
+		if length2 == 0 {
+			return 0
+		}
+
+		return ((x-x0)*dx + (y-y0)*dy) / length2
+	})
+}
+
+// DrawRadial draws the gradient as circles around the center (cx, cy) into dst, replacing the pixels within its
+// bounds. The gradient ends at the radius.
+func (g Gradient) DrawRadial(dst draw.Image, cx, cy, radius float64) {
+	g.draw(dst, func(x, y float64) float64 {
+		if radius <= 0 {
+			return 1
+		}
+
+		return math.Hypot(x-cx, y-cy) / radius
+	})
+}
+
+// DrawConic draws the gradient around the center (cx, cy) into dst, replacing the pixels within its bounds. Like in CSS
+// the gradient starts at the angle in degrees, where 0 points up, and goes clockwise.
+func (g Gradient) DrawConic(dst draw.Image, cx, cy, angle float64) {
+	g.draw(dst, func(x, y float64) float64 {
+		// In image coordinates y points down, so this angle goes clockwise from the top.
+		deg := math.Atan2(x-cx, cy-y) * 180.0 / math.Pi
+
+		return normalizeHue(deg-angle) / 360.0
+	})
+}
+
+// draw sets every pixel of dst to the color of the gradient at the position returned by at for the center of the pixel.
+func (g Gradient) draw(dst draw.Image, at func(x, y float64) float64) {
+	bounds := dst.Bounds()
+
+	for y := bounds.Min.Y; y < bounds.Max.Y; y++ {
+		for x := bounds.Min.X; x < bounds.Max.X; x++ {
+			dst.Set(x, y, g.At(at(float64(x)+0.5, float64(y)+0.5)))
+		}
+	}
+}
@@ -0,0 +1,222 @@
+package colorx
+
+import (
+	"image"
+	"image/color"
+	"testing"
+
+	"github.com/somebadcode/go-colorx/v2/internal/mathx"
+)
+
+func TestEvenStops(t *testing.T) {
+	tests := []struct {
+		name   string
+		colors []color.Color
+		want   []float64
+	}{
+		{name: "none"},
+		{name: "one", colors: []color.Color{color.Black}, want: []float64{0}},
+		{name: "three", colors: []color.Color{color.Black, color.White, color.Black}, want: []float64{0, 0.5, 1}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := EvenStops(tt.colors...)
+			if len(got) != len(tt.want) {
+				t.Fatalf("EvenStops() returned %d stops, want %d", len(got), len(tt.want))
+			}
+
+			for i := range got {
+				if got[i].Position != tt.want[i] || got[i].Color != tt.colors[i] {
+					t.Errorf("EvenStops()[%d] = %+v, want position %v", i, got[i], tt.want[i])
+				}
+			}
+		})
+	}
+}
+
+func TestGradient_At(t *testing.T) {
+	black := CSS{Opacity: 1.0}
+	white := CSS{R: 0xFF, G: 0xFF, B: 0xFF, Opacity: 1.0}
+	red := CSS{R: 0xFF, Opacity: 1.0}
+	blue := CSS{B: 0xFF, Opacity: 1.0}
+
+	srgb := InSpace(SpaceSRGB)
+
+	tests := []struct {
+		name     string
+		gradient Gradient
+		t        float64
+		want     color.Color
+	}{
+		{
+			name:     "empty",
+			gradient: NewGradient(nil),
+			t:        0.5,
+			want:     color.Transparent,
+		},
+		{
+			name:     "single",
+			gradient: NewGradient(EvenStops(red)),
+			t:        0.5,
+			want:     red,
+		},
+		{
+			name:     "start",
+			gradient: NewGradient(EvenStops(black, white), srgb),
+			t:        0,
+			want:     black,
+		},
+		{
+			name:     "end",
+			gradient: NewGradient(EvenStops(black, white), srgb),
+			t:        1,
+			want:     white,
+		},
+		{
+			name:     "before",
+			gradient: NewGradient([]ColorStop{{Color: black, Position: 0.25}, {Color: white, Position: 0.75}}, srgb),
+			t:        0.1,
+			want:     black,
+		},
+		{
+			name:     "after",
+			gradient: NewGradient([]ColorStop{{Color: black, Position: 0.25}, {Color: white, Position: 0.75}}, srgb),
+			t:        1.5,
+			want:     white,
+		},
+		{
+			name:     "middle",
+			gradient: NewGradient(EvenStops(black, white), srgb),
+			t:        0.5,
+			want:     SRGB{R: 0.5, G: 0.5, B: 0.5, A: 1.0},
+		},
+		{
+			name:     "second_segment",
+			gradient: NewGradient(EvenStops(black, white, red), srgb),
+			t:        0.75,
+			want:     SRGB{R: 1.0, G: 0.5, B: 0.5, A: 1.0},
+		},
+		{
+			name:     "positioned",
+			gradient: NewGradient([]ColorStop{{Color: black, Position: 0.25}, {Color: white, Position: 0.75}}, srgb),
+			t:        0.375,
+			want:     SRGB{R: 0.25, G: 0.25, B: 0.25, A: 1.0},
+		},
+		{
+			name: "hard_stop",
+			gradient: NewGradient([]ColorStop{
+				{Color: black},
+				{Color: black, Position: 0.5},
+				{Color: red, Position: 0.5},
+				{Color: red, Position: 1.0},
+			}, srgb),
+			t:    0.5,
+			want: SRGB{R: 1.0, A: 1.0},
+		},
+		{
+			name: "out_of_order",
+			gradient: NewGradient([]ColorStop{
+				{Color: black},
+				{Color: white, Position: 0.6},
+				{Color: red, Position: 0.3},
+			}, srgb),
+			t:    0.7,
+			want: red,
+		},
+		{
+			name:     "hint",
+			gradient: NewGradient([]ColorStop{{Color: black, Hint: 0.25}, {Color: white, Position: 1.0}}, srgb),
+			t:        0.25,
+			want:     SRGB{R: 0.5, G: 0.5, B: 0.5, A: 1.0},
+		},
+		{
+			name:     "hue",
+			gradient: NewGradient(EvenStops(red, blue), InSpace(SpaceHSL), WithHueInterpolation(HueLonger)),
+			t:        0.5,
+			want:     HSLA{H: 120.0, S: 1.0, L: 0.5, A: 1.0},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := SRGBModel.Convert(tt.gradient.At(tt.t)).(SRGB)
+			want := SRGBModel.Convert(tt.want).(SRGB)
+			if !mathx.EqualP(got.R, want.R, 1e-4) || !mathx.EqualP(got.G, want.G, 1e-4) ||
+				!mathx.EqualP(got.B, want.B, 1e-4) || !mathx.EqualP(got.A, want.A, 1e-4) {
+				t.Errorf("At() = %+v, want %+v", got, want)
+			}
+		})
+	}
+}
+
+func TestGradient_Draw(t *testing.T) {
+	gradient := NewGradient(EvenStops(color.Black, color.White), InSpace(SpaceSRGB))
+
+	type pixel struct {
+		x, y int
+		want color.Gray
+	}
+	tests := []struct {
+		name   string
+		draw   func(dst *image.Gray)
+		pixels []pixel
+	}{
+		{
+			name: "linear",
+			draw: func(dst *image.Gray) { gradient.DrawLinear(dst, 0, 0, 16, 0) },
+			pixels: []pixel{
+				{x: 0, y: 0, want: color.Gray{Y: 0x08}},
+				{x: 7, y: 15, want: color.Gray{Y: 0x78}},
+				{x: 15, y: 8, want: color.Gray{Y: 0xF7}},
+			},
+		},
+		{
+			name: "linear_diagonal",
+			draw: func(dst *image.Gray) { gradient.DrawLinear(dst, 16, 16, 0, 0) },
+			pixels: []pixel{
+				{x: 0, y: 0, want: color.Gray{Y: 0xF7}},
+				{x: 15, y: 0, want: color.Gray{Y: 0x80}},
+				{x: 15, y: 15, want: color.Gray{Y: 0x08}},
+			},
+		},
+		{
+			name: "radial",
+			draw: func(dst *image.Gray) { gradient.DrawRadial(dst, 8, 8, 4) },
+			pixels: []pixel{
+				{x: 7, y: 7, want: color.Gray{Y: 0x2D}},
+				{x: 9, y: 7, want: color.Gray{Y: 0x65}},
+				{x: 0, y: 0, want: color.Gray{Y: 0xFF}},
+			},
+		},
+		{
+			name: "conic",
+			draw: func(dst *image.Gray) { gradient.DrawConic(dst, 8.5, 8.5, 90) },
+			pixels: []pixel{
+				{x: 15, y: 8, want: color.Gray{Y: 0x00}},
+				{x: 8, y: 15, want: color.Gray{Y: 0x40}},
+				{x: 0, y: 8, want: color.Gray{Y: 0x80}},
+				{x: 8, y: 0, want: color.Gray{Y: 0xBF}},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			dst := image.NewGray(image.Rect(0, 0, 16, 16))
+			tt.draw(dst)
+
+			for _, p := range tt.pixels {
+				if got := dst.GrayAt(p.x, p.y); got != p.want {
+					t.Errorf("pixel (%d, %d) = %v, want %v", p.x, p.y, got, p.want)
+				}
+			}
+		})
+	}
+}
+
+func BenchmarkGradient_DrawLinear(b *testing.B) {
+	gradient := NewGradient(EvenStops(color.Black, CSS{R: 0xFF, Opacity: 1.0}, color.White), InSpace(SpaceOKLCh))
+	dst := image.NewNRGBA(image.Rect(0, 0, 64, 64))
+
+	for n := 0; n < b.N; n++ {
+		gradient.DrawLinear(dst, 0, 0, 64, 64)
+	}
+}
@@ -0,0 +1,158 @@
+package colorx
+
+import (
+	"image/color"
+)
+
+// Wheel is the color wheel that the harmony functions rotate hues on.
+type Wheel int
+
+const (
+	WheelHSL   Wheel = iota // The hue of HSL, as on a screen, where the complement of red is cyan
+	WheelOKLCh              // The hue of OKLCh, which keeps the perceived lightness when rotating
+	WheelRYB                // The red, yellow and blue wheel of painters, where the complement of red is green
+)
+
+// rybHues and rgbHues are the hues of red, orange, yellow, green, blue, purple and red again on the RYB wheel and on
+// the HSL wheel. Hues in between are interpolated linearly.
+var (
+	rybHues = [...]float64{0, 60, 120, 180, 240, 300, 360}
+	rgbHues = [...]float64{0, 30, 60, 120, 240, 300, 360}
+)
+
+// Complementary returns the color and its complement, the color on the opposite side of the wheel. The colors have the
+// type of the wheel: HSLA for WheelHSL and WheelRYB, OKLCh for WheelOKLCh. Rotating on WheelOKLCh can give colors
+// outside of the sRGB gamut.
+func Complementary(c color.Color, wheel Wheel) []color.Color {
+	return rotateHues(c, wheel, 0, 180)
+}
+
+// SplitComplementary returns the color and the two colors next to its complement, 150 and 210 degrees away.
+func SplitComplementary(c color.Color, wheel Wheel) []color.Color {
+	return rotateHues(c, wheel, 0, 150, 210)
+}
+
+// Analogous returns the color and its neighbors on the wheel, 30 degrees away on either side.
+func Analogous(c color.Color, wheel Wheel) []color.Color {
+	return rotateHues(c, wheel, 0, 30, -30)
+}
+
+// Triadic returns the color and two colors that divide the wheel in three equal parts with it.
+func Triadic(c color.Color, wheel Wheel) []color.Color {
+	return rotateHues(c, wheel, 0, 120, 240)
+}
+
+// Tetradic returns the color and three colors that form a rectangle on the wheel with it, two pairs of complements 60
+// degrees apart.
+func Tetradic(c color.Color, wheel Wheel) []color.Color {
+	return rotateHues(c, wheel, 0, 60, 180, 240)
+}
+
+// Square returns the color and three colors that divide the wheel in four equal parts with it.
+func Square(c color.Color, wheel Wheel) []color.Color {
+	return rotateHues(c, wheel, 0, 90, 180, 270)
+}
+
+// Tints returns n colors going from the color towards white, starting with the color itself. The colors are mixed in
+// sRGB for WheelHSL and WheelRYB, like adding white paint, and are of type SRGB. For WheelOKLCh they are mixed in OKLCh
+// and are of type OKLCh. Alpha is kept.
+func Tints(c color.Color, n int, wheel Wheel) []color.Color {
+	return monochromatic(c, n, wheel, 1.0)
+}
+
+// Shades returns n colors going from the color towards black, starting with the color itself.
+func Shades(c color.Color, n int, wheel Wheel) []color.Color {
+	return monochromatic(c, n, wheel, 0.0)
+}
+
+// Tones returns n colors going from the color towards a gray of the same lightness, starting with the color itself.
+func Tones(c color.Color, n int, wheel Wheel) []color.Color {
+	var lightness float64
+
+	switch wheel {
+	case WheelOKLCh:
+		lightness = convertOKLab(c).L
+
+	default: // WheelHSL, WheelRYB
+		lightness = hslaModel(c).(HSLA).L
+	}
+
+	return monochromatic(c, n, wheel, lightness)
+}
+
+func rotateHues(c color.Color, wheel Wheel, degrees ...float64) []color.Color {
+	colors := make([]color.Color, len(degrees))
+
+	switch wheel {
+	case WheelOKLCh:
+		base := oklchModel(c).(OKLCh)
+		for i, deg := range degrees {
+			lch := base
+			lch.H = normalizeHue(base.H + deg)
+			colors[i] = lch
+		}
+
+	case WheelRYB:
+		base := hslaModel(c).(HSLA)
+		ryb := rgbToRYBHue(base.H)
+		for i, deg := range degrees {
+			hsla := base
+			hsla.H = rybToRGBHue(normalizeHue(ryb + deg))
+			colors[i] = hsla
+		}
+
+	default: // WheelHSL
+		base := hslaModel(c).(HSLA)
+		for i, deg := range degrees {
+			colors[i] = base.Rotate(deg)
+		}
+	}
+
+	return colors
+}
+
+// monochromatic returns n colors going from the color towards the gray with the lightness on the wheel.
+func monochromatic(c color.Color, n int, wheel Wheel, lightness float64) []color.Color {
+	if n <= 0 {
+		return nil
+	}
+
+	_, _, _, alpha := toSRGB(c)
+
+	space := SpaceSRGB
+	gray := color.Color(SRGB{R: lightness, G: lightness, B: lightness, A: alpha})
+
+	if wheel == WheelOKLCh {
+		space = SpaceOKLCh
+		gray = OKLCh{L: lightness, Alpha: alpha}
+	}
+
+	colors := make([]color.Color, n)
+	for i := range colors {
+		colors[i] = Mix(c, gray, float64(i)/float64(n), InSpace(space))
+	}
+
+	return colors
+}
+
+// rgbToRYBHue converts a hue on the HSL wheel to a hue on the RYB wheel.
+func rgbToRYBHue(h float64) float64 {
+	return mapHue(h, rgbHues[:], rybHues[:])
+}
+
+// rybToRGBHue converts a hue on the RYB wheel to a hue on the HSL wheel.
+func rybToRGBHue(h float64) float64 {
+	return mapHue(h, rybHues[:], rgbHues[:])
+}
+
+// mapHue maps a hue ∈ [0, 360) from one wheel to another by linear interpolation between the hues in from and to.
+func mapHue(h float64, from, to []float64) float64 {
+	for i := 1; i < len(from); i++ {
+		if h < from[i] {
+			t := (h - from[i-1]) / (from[i] - from[i-1])
+			return normalizeHue(lerp(to[i-1], to[i], t))
+		}
+	}
+
+	return 0
+}
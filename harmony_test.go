@@ -0,0 +1,151 @@
+package colorx
+
+import (
+	"image/color"
+	"testing"
+
+	"github.com/somebadcode/go-colorx/v2/internal/mathx"
+)
+
+func TestHarmonies(t *testing.T) {
+	red := CSS{R: 0xFF, Opacity: 1.0}
+	blue := CSS{B: 0xFF, Opacity: 1.0}
+
+	tests := []struct {
+		name string
+		got  []color.Color
+		want []color.Color
+	}{
+		{
+			name: "complementary_hsl",
+			got:  Complementary(red, WheelHSL),
+			want: []color.Color{red, CSS{G: 0xFF, B: 0xFF, Opacity: 1.0}},
+		},
+		{
+			name: "complementary_ryb",
+			got:  Complementary(red, WheelRYB),
+			want: []color.Color{red, CSS{G: 0xFF, Opacity: 1.0}},
+		},
+		{
+			name: "complementary_ryb_blue",
+			got:  Complementary(blue, WheelRYB),
+			want: []color.Color{blue, CSS{R: 0xFF, G: 0x80, Opacity: 1.0}},
+		},
+		{
+			name: "split_complementary_hsl",
+			got:  SplitComplementary(red, WheelHSL),
+			want: []color.Color{red, CSS{G: 0xFF, B: 0x80, Opacity: 1.0}, CSS{G: 0x80, B: 0xFF, Opacity: 1.0}},
+		},
+		{
+			name: "analogous_hsl",
+			got:  Analogous(red, WheelHSL),
+			want: []color.Color{red, CSS{R: 0xFF, G: 0x80, Opacity: 1.0}, CSS{R: 0xFF, B: 0x80, Opacity: 1.0}},
+		},
+		{
+			name: "triadic_hsl",
+			got:  Triadic(red, WheelHSL),
+			want: []color.Color{red, CSS{G: 0xFF, Opacity: 1.0}, blue},
+		},
+		{
+			name: "triadic_ryb",
+			got:  Triadic(red, WheelRYB),
+			want: []color.Color{red, CSS{R: 0xFF, G: 0xFF, Opacity: 1.0}, blue},
+		},
+		{
+			name: "tetradic_hsl",
+			got:  Tetradic(red, WheelHSL),
+			want: []color.Color{
+				red,
+				CSS{R: 0xFF, G: 0xFF, Opacity: 1.0},
+				CSS{G: 0xFF, B: 0xFF, Opacity: 1.0},
+				blue,
+			},
+		},
+		{
+			name: "square_hsl",
+			got:  Square(red, WheelHSL),
+			want: []color.Color{
+				red,
+				CSS{R: 0x80, G: 0xFF, Opacity: 1.0},
+				CSS{G: 0xFF, B: 0xFF, Opacity: 1.0},
+				CSS{R: 0x80, B: 0xFF, Opacity: 1.0},
+			},
+		},
+		{
+			name: "complementary_oklch",
+			got:  Complementary(OKLCh{L: 0.7, C: 0.1, H: 30.0, Alpha: 1.0}, WheelOKLCh),
+			want: []color.Color{OKLCh{L: 0.7, C: 0.1, H: 30.0, Alpha: 1.0}, OKLCh{L: 0.7, C: 0.1, H: 210.0, Alpha: 1.0}},
+		},
+		{
+			name: "tints",
+			got:  Tints(red, 4, WheelHSL),
+			want: []color.Color{
+				red,
+				SRGB{R: 1.0, G: 0.25, B: 0.25, A: 1.0},
+				SRGB{R: 1.0, G: 0.5, B: 0.5, A: 1.0},
+				SRGB{R: 1.0, G: 0.75, B: 0.75, A: 1.0},
+			},
+		},
+		{
+			name: "shades_translucent",
+			got:  Shades(CSS{R: 0xFF, Opacity: 0.5}, 2, WheelRYB),
+			want: []color.Color{CSS{R: 0xFF, Opacity: 0.5}, SRGB{R: 0.5, A: 0.5}},
+		},
+		{
+			name: "tones",
+			got:  Tones(red, 2, WheelHSL),
+			want: []color.Color{red, SRGB{R: 0.75, G: 0.25, B: 0.25, A: 1.0}},
+		},
+		{
+			name: "tones_oklch",
+			got:  Tones(OKLCh{L: 0.7, C: 0.1, H: 30.0, Alpha: 1.0}, 2, WheelOKLCh),
+			want: []color.Color{OKLCh{L: 0.7, C: 0.1, H: 30.0, Alpha: 1.0}, OKLCh{L: 0.7, C: 0.05, H: 30.0, Alpha: 1.0}},
+		},
+		{
+			name: "none",
+			got:  Tints(red, 0, WheelHSL),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if len(tt.got) != len(tt.want) {
+				t.Fatalf("got %d colors, want %d", len(tt.got), len(tt.want))
+			}
+
+			for i := range tt.got {
+				got := OKLabModel.Convert(tt.got[i]).(OKLab)
+				want := OKLabModel.Convert(tt.want[i]).(OKLab)
+				if !mathx.EqualP(got.L, want.L, 2e-3) || !mathx.EqualP(got.A, want.A, 2e-3) ||
+					!mathx.EqualP(got.B, want.B, 2e-3) || !mathx.EqualP(got.Alpha, want.Alpha, 2e-3) {
+					t.Errorf("color %d = %+v, want %+v", i, tt.got[i], tt.want[i])
+				}
+			}
+		})
+	}
+}
+
+func TestRYBHue(t *testing.T) {
+	tests := []struct {
+		name string
+		rgb  float64
+		ryb  float64
+	}{
+		{name: "red", rgb: 0.0, ryb: 0.0},
+		{name: "orange", rgb: 30.0, ryb: 60.0},
+		{name: "yellow", rgb: 60.0, ryb: 120.0},
+		{name: "green", rgb: 120.0, ryb: 180.0},
+		{name: "cyan", rgb: 180.0, ryb: 210.0},
+		{name: "blue", rgb: 240.0, ryb: 240.0},
+		{name: "magenta", rgb: 330.0, ryb: 330.0},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := rgbToRYBHue(tt.rgb); !mathx.Equal(got, tt.ryb) {
+				t.Errorf("rgbToRYBHue() = %v, want %v", got, tt.ryb)
+			}
+			if got := rybToRGBHue(tt.ryb); !mathx.Equal(got, tt.rgb) {
+				t.Errorf("rybToRGBHue() = %v, want %v", got, tt.rgb)
+			}
+		})
+	}
+}
@@ -5,8 +5,9 @@ import (
 	"math"
 )
 
-// HSLA is an implementation of the HSV (Hue, Saturation and Value) color model. HSV is also known as HSB (Hue,
-// Saturation, Brightness).
+// HSLA is an implementation of the HSL (Hue, Saturation and Lightness) color model. A component can be NaN to mark it
+// as missing, like the CSS keyword "none". A missing component converts as zero, but Mix takes the value of the other
+// color instead, which is what a gray needs to blend without going through red.
 type HSLA struct {
 	H float64 // Hue ∈ [0, 360)
 	S float64 // Saturation ∈ [0, 1]
@@ -22,25 +23,35 @@ func hslaModel(c color.Color) color.Color {
 		return c
 	}
 
-	r, g, b, a := c.RGBA()
-	h, s, l, ha := RGBAToHSLA(uint8(r>>8), uint8(g>>8), uint8(b>>8), uint8(a>>8))
+	// The channels of color.Color are alpha-premultiplied, HSLA is not.
+	r, g, b, a := toSRGB(c)
+	h, s, l := rgbToHSL(r, g, b)
 	return HSLA{
 		H: h,
 		S: s,
 		L: l,
-		A: ha,
+		A: a,
 	}
 }
 
-// RGBAToHSLA converts RGBA to Hue, Saturation, Value and Alpha.
+// RGBAToHSLA converts RGBA to Hue, Saturation, Lightness and Alpha. The hue of a gray is zero, use
+// HSLA.MarkPowerless to mark it as missing.
 func RGBAToHSLA(r, g, b, a uint8) (float64, float64, float64, float64) {
-	var hue, saturation, lightness, alpha float64
+	h, s, l := rgbToHSL(float64(r)/math.MaxUint8, float64(g)/math.MaxUint8, float64(b)/math.MaxUint8)
 
-	// Convert R, G and B to floats.
-	red := float64(r) / math.MaxUint8
-	green := float64(g) / math.MaxUint8
-	blue := float64(b) / math.MaxUint8
-	alpha = float64(a) / math.MaxUint8
+	return h, s, l, float64(a) / math.MaxUint8
+}
+
+// RGBA64ToHSLA converts 16-bit RGBA to Hue, Saturation, Lightness and Alpha.
+func RGBA64ToHSLA(r, g, b, a uint16) (float64, float64, float64, float64) {
+	h, s, l := rgbToHSL(float64(r)/math.MaxUint16, float64(g)/math.MaxUint16, float64(b)/math.MaxUint16)
+
+	return h, s, l, float64(a) / math.MaxUint16
+}
+
+// rgbToHSL converts red, green and blue ∈ [0, 1] to hue, saturation and lightness.
+func rgbToHSL(red, green, blue float64) (float64, float64, float64) {
+	var hue, saturation, lightness float64
 
 	// Get the most and least dominant colors.
 	cMax := math.Max(red, math.Max(green, blue))
@@ -74,55 +85,166 @@ func RGBAToHSLA(r, g, b, a uint8) (float64, float64, float64, float64) {
 
 	hue = math.Mod(hue, 360.0)
 
-	return hue, saturation, lightness, alpha
+	return hue, saturation, lightness
 }
 
 // RGBA returns the alpha-premultiplied red, green, blue and alpha values for the color.
 func (hsla HSLA) RGBA() (r, g, b, a uint32) {
-	var rgba color.RGBA
+	red, green, blue := hslToRGB(orZero(hsla.H), orZero(hsla.S), orZero(hsla.L))
+
+	return premultiply(red, green, blue, hsla.A)
+}
 
-	hsla.H = math.Mod(hsla.H+360.0, 360.0)
+func (hsla HSLA) linear() (r, g, b, a float64) {
+	return linearizeRGB(hsla.srgb())
+}
+
+func (hsla HSLA) srgb() (r, g, b, a float64) {
+	r, g, b = hslToRGB(orZero(hsla.H), orZero(hsla.S), orZero(hsla.L))
+
+	return r, g, b, orZero(hsla.A)
+}
 
-	c := (1 - math.Abs(2*hsla.L-1)) * hsla.S
-	x := c * (1 - math.Abs(math.Mod(hsla.H/60.0, 2.0)-1))
-	m := hsla.L - c/2
+// String returns the color in the CSS hsl() function, such as "hsl(120 50% 25% / 0.8)". A missing component is
+// written as "none".
+func (hsla HSLA) String() string {
+	return string(hsla.AppendText(nil))
+}
+
+// AppendText appends the color in the CSS hsl() function, like String, to dst and returns the extended buffer. It does
+// not allocate if dst has room for the text.
+func (hsla HSLA) AppendText(dst []byte) []byte {
+	return appendHueFunction(dst, "hsl(", hsla.H, hsla.S, hsla.L, hsla.A, cssDecimals)
+}
 
-	rgba.A = uint8(hsla.A * math.MaxUint8)
+// hsva converts the color to HSVA without going through RGB, the inverse of HSVA.hsla.
+func (hsla HSLA) hsva() HSVA {
+	v := hsla.L + hsla.S*math.Min(hsla.L, 1.0-hsla.L)
+
+	s := math.NaN()
+	if !math.IsNaN(v) {
+		s = 0
+		if v > 0 {
+			s = 2.0 * (1.0 - hsla.L/v)
+		}
+	}
+
+	return HSVA{H: hsla.H, S: s, V: v, A: hsla.A}
+}
+
+// MarkPowerless returns the color with the hue marked as missing if it is powerless, because the color is gray.
+func (hsla HSLA) MarkPowerless() HSLA {
+	if SpaceHSL.powerless([3]float64{hsla.H, hsla.S, hsla.L}) {
+		hsla.H = math.NaN()
+	}
+
+	return hsla
+}
+
+// hslToRGB converts hue, saturation and lightness to red, green and blue, all channels ∈ [0, 1].
+func hslToRGB(h, s, l float64) (float64, float64, float64) {
+	h = normalizeHue(h)
+
+	c := (1 - math.Abs(2*l-1)) * s
+	x := c * (1 - math.Abs(math.Mod(h/60.0, 2.0)-1))
+	m := l - c/2
 
 	// sextant will be the sextant of the dominant color.
-	sextant, _ := math.Modf(hsla.H / 60.0)
+	sextant, _ := math.Modf(h / 60.0)
 
 	switch int(sextant) {
 	case 0:
-		rgba.R = uint8(math.Floor((c + m) * math.MaxUint8))
-		rgba.G = uint8(math.Floor((x + m) * math.MaxUint8))
-		rgba.B = uint8(math.Floor(m * math.MaxUint8))
+		return c + m, x + m, m
 
 	case 1:
-		rgba.R = uint8(math.Floor((x + m) * math.MaxUint8))
-		rgba.G = uint8(math.Floor((c + m) * math.MaxUint8))
-		rgba.B = uint8(math.Floor(m * math.MaxUint8))
+		return x + m, c + m, m
 
 	case 2:
-		rgba.R = uint8(math.Floor(m * math.MaxUint8))
-		rgba.G = uint8(math.Floor((c + m) * math.MaxUint8))
-		rgba.B = uint8(math.Floor((x + m) * math.MaxUint8))
+		return m, c + m, x + m
 
 	case 3:
-		rgba.R = uint8(math.Floor(m * math.MaxUint8))
-		rgba.G = uint8(math.Floor((x + m) * math.MaxUint8))
-		rgba.B = uint8(math.Floor((c + m) * math.MaxUint8))
+		return m, x + m, c + m
 
 	case 4:
-		rgba.R = uint8(math.Floor((x + m) * math.MaxUint8))
-		rgba.G = uint8(math.Floor(m * math.MaxUint8))
-		rgba.B = uint8(math.Floor((c + m) * math.MaxUint8))
+		return x + m, m, c + m
 
 	default: // case 5
-		rgba.R = uint8(math.Floor((c + m) * math.MaxUint8))
-		rgba.G = uint8(math.Floor(m * math.MaxUint8))
-		rgba.B = uint8(math.Floor((x + m) * math.MaxUint8))
+		return c + m, m, x + m
+	}
+}
+
+// Clamp returns the color with the hue wrapped around to [0, 360) and the other components clamped to [0, 1]. Missing
+// components stay missing.
+func (hsla HSLA) Clamp() HSLA {
+	return HSLA{
+		H: normalizeHue(hsla.H),
+		S: clampPresent(hsla.S, 0, 1),
+		L: clampPresent(hsla.L, 0, 1),
+		A: clampPresent(hsla.A, 0, 1),
 	}
+}
+
+// Rotate returns the color with the hue rotated by deg degrees. Negative degrees rotate the other way.
+func (hsla HSLA) Rotate(deg float64) HSLA {
+	hsla.H += deg
+
+	return hsla.Clamp()
+}
+
+// Complement returns the complementary color, the color with the hue rotated by 180 degrees.
+func (hsla HSLA) Complement() HSLA {
+	return hsla.Rotate(180.0)
+}
+
+// Saturate returns the color with amount added to the saturation. A negative amount desaturates.
+func (hsla HSLA) Saturate(amount float64) HSLA {
+	hsla.S += amount
+
+	return hsla.Clamp()
+}
+
+// Desaturate returns the color with amount subtracted from the saturation.
+func (hsla HSLA) Desaturate(amount float64) HSLA {
+	return hsla.Saturate(-amount)
+}
+
+// ScaleSaturation returns the color with the saturation multiplied by factor.
+func (hsla HSLA) ScaleSaturation(factor float64) HSLA {
+	hsla.S *= factor
+
+	return hsla.Clamp()
+}
+
+// Lighten returns the color with amount added to the lightness. A negative amount darkens.
+func (hsla HSLA) Lighten(amount float64) HSLA {
+	hsla.L += amount
+
+	return hsla.Clamp()
+}
+
+// Darken returns the color with amount subtracted from the lightness.
+func (hsla HSLA) Darken(amount float64) HSLA {
+	return hsla.Lighten(-amount)
+}
+
+// Grayscale returns the color desaturated to gray. The hue is kept so that the color can be saturated again.
+func (hsla HSLA) Grayscale() HSLA {
+	hsla.S = 0
+
+	return hsla.Clamp()
+}
+
+// Invert returns the negative of the color, the color with each of the red, green and blue channels inverted.
+func (hsla HSLA) Invert() HSLA {
+	hsla.H += 180.0
+	hsla.L = 1.0 - hsla.L
+
+	return hsla.Clamp()
+}
+
+// WithAlpha returns the color with the alpha replaced.
+func (hsla HSLA) WithAlpha(alpha float64) HSLA {
+	hsla.A = alpha
 
-	return rgba.RGBA()
+	return hsla.Clamp()
 }
@@ -2,6 +2,7 @@ package colorx
 
 import (
 	"image/color"
+	"math"
 	"testing"
 
 	"github.com/somebadcode/go-colorx/v2/internal/mathx"
@@ -27,6 +28,29 @@ func TestHSLAModel(t *testing.T) {
 				},
 			},
 			want: HSLA{
+				L: 1.0,
+				A: 0.5,
+			},
+		},
+		{
+			name: "nrgba",
+			args: args{
+				c: color.NRGBA{R: 0xFF, A: 0x80},
+			},
+			want: HSLA{
+				S: 1.0,
+				L: 0.5,
+				A: 0.5,
+			},
+		},
+		{
+			name: "nrgba64",
+			args: args{
+				c: color.NRGBA64{G: 0xFFFF, A: 0x8000},
+			},
+			want: HSLA{
+				H: 120.0,
+				S: 1.0,
 				L: 0.5,
 				A: 0.5,
 			},
@@ -61,6 +85,9 @@ func TestHSLAModel(t *testing.T) {
 			if !mathx.EqualP(got.L, tt.want.L, 1e-2) {
 				t.Errorf("HSLAModel.Convert() got L = %f, want %f", got.L, tt.want.L)
 			}
+			if !mathx.EqualP(got.A, tt.want.A, 1e-2) {
+				t.Errorf("HSLAModel.Convert() got A = %f, want %f", got.A, tt.want.A)
+			}
 		})
 	}
 }
@@ -243,24 +270,29 @@ func TestHSLA_RGBA(t *testing.T) {
 	}{
 		{
 			name:   "black",
-			fields: fields{},
+			fields: fields{A: 1.0},
+			wantA:  0xFFFF,
 		},
 		{
 			name: "white",
 			fields: fields{
 				L: 1.0,
+				A: 1.0,
 			},
 			wantR: 0xFFFF,
 			wantG: 0xFFFF,
 			wantB: 0xFFFF,
+			wantA: 0xFFFF,
 		},
 		{
 			name: "red",
 			fields: fields{
 				S: 1.0,
 				L: 0.5,
+				A: 1.0,
 			},
 			wantR: 0xFFFF,
+			wantA: 0xFFFF,
 		},
 		{
 			name: "lime",
@@ -268,8 +300,10 @@ func TestHSLA_RGBA(t *testing.T) {
 				H: 120.0,
 				S: 1.0,
 				L: 0.5,
+				A: 1.0,
 			},
 			wantG: 0xFFFF,
+			wantA: 0xFFFF,
 		},
 		{
 			name: "blue",
@@ -277,8 +311,10 @@ func TestHSLA_RGBA(t *testing.T) {
 				H: 240.0,
 				S: 1.0,
 				L: 0.5,
+				A: 1.0,
 			},
 			wantB: 0xFFFF,
+			wantA: 0xFFFF,
 		},
 		{
 			name: "yellow",
@@ -286,9 +322,11 @@ func TestHSLA_RGBA(t *testing.T) {
 				H: 60.0,
 				S: 1.0,
 				L: 0.5,
+				A: 1.0,
 			},
 			wantR: 0xFFFF,
 			wantG: 0xFFFF,
+			wantA: 0xFFFF,
 		},
 		{
 			name: "cyan",
@@ -296,9 +334,11 @@ func TestHSLA_RGBA(t *testing.T) {
 				H: 180.0,
 				S: 1.0,
 				L: 0.5,
+				A: 1.0,
 			},
 			wantG: 0xFFFF,
 			wantB: 0xFFFF,
+			wantA: 0xFFFF,
 		},
 		{
 			name: "cyan_negative",
@@ -306,9 +346,11 @@ func TestHSLA_RGBA(t *testing.T) {
 				H: -180.0,
 				S: 1.0,
 				L: 0.5,
+				A: 1.0,
 			},
 			wantG: 0xFFFF,
 			wantB: 0xFFFF,
+			wantA: 0xFFFF,
 		},
 		{
 			name: "magenta",
@@ -316,9 +358,11 @@ func TestHSLA_RGBA(t *testing.T) {
 				H: 300.0,
 				S: 1.0,
 				L: 0.5,
+				A: 1.0,
 			},
 			wantR: 0xFFFF,
 			wantB: 0xFFFF,
+			wantA: 0xFFFF,
 		},
 		{
 			name: "magenta_negative",
@@ -326,35 +370,43 @@ func TestHSLA_RGBA(t *testing.T) {
 				H: -60.0,
 				S: 1.0,
 				L: 0.5,
+				A: 1.0,
 			},
 			wantR: 0xFFFF,
 			wantB: 0xFFFF,
+			wantA: 0xFFFF,
 		},
 		{
 			name: "silver",
 			fields: fields{
 				L: 0.75,
+				A: 1.0,
 			},
-			wantR: 0xBFBF,
-			wantG: 0xBFBF,
-			wantB: 0xBFBF,
+			wantR: 0xBFFF,
+			wantG: 0xBFFF,
+			wantB: 0xBFFF,
+			wantA: 0xFFFF,
 		},
 		{
 			name: "gray",
 			fields: fields{
 				L: 0.5,
+				A: 1.0,
 			},
-			wantR: 0x7F7F,
-			wantG: 0x7F7F,
-			wantB: 0x7F7F,
+			wantR: 0x8000,
+			wantG: 0x8000,
+			wantB: 0x8000,
+			wantA: 0xFFFF,
 		},
 		{
 			name: "maroon",
 			fields: fields{
 				S: 1.0,
 				L: 0.25,
+				A: 1.0,
 			},
-			wantR: 0x7F7F,
+			wantR: 0x8000,
+			wantA: 0xFFFF,
 		},
 		{
 			name: "olive",
@@ -362,9 +414,11 @@ func TestHSLA_RGBA(t *testing.T) {
 				H: 60.0,
 				S: 1.0,
 				L: 0.25,
+				A: 1.0,
 			},
-			wantR: 0x7F7F,
-			wantG: 0x7F7F,
+			wantR: 0x8000,
+			wantG: 0x8000,
+			wantA: 0xFFFF,
 		},
 		{
 			name: "green",
@@ -372,8 +426,10 @@ func TestHSLA_RGBA(t *testing.T) {
 				H: 120.0,
 				S: 1.0,
 				L: 0.25,
+				A: 1.0,
 			},
-			wantG: 0x7F7F,
+			wantG: 0x8000,
+			wantA: 0xFFFF,
 		},
 		{
 			name: "purple",
@@ -381,9 +437,11 @@ func TestHSLA_RGBA(t *testing.T) {
 				H: 300.0,
 				S: 1.0,
 				L: 0.25,
+				A: 1.0,
 			},
-			wantR: 0x7F7F,
-			wantB: 0x7F7F,
+			wantR: 0x8000,
+			wantB: 0x8000,
+			wantA: 0xFFFF,
 		},
 		{
 			name: "teal",
@@ -391,9 +449,11 @@ func TestHSLA_RGBA(t *testing.T) {
 				H: 180.0,
 				S: 1.0,
 				L: 0.25,
+				A: 1.0,
 			},
-			wantG: 0x7F7F,
-			wantB: 0x7F7F,
+			wantG: 0x8000,
+			wantB: 0x8000,
+			wantA: 0xFFFF,
 		},
 		{
 			name: "navy",
@@ -401,8 +461,44 @@ func TestHSLA_RGBA(t *testing.T) {
 				H: 240.0,
 				S: 1.0,
 				L: 0.25,
+				A: 1.0,
 			},
-			wantB: 0x7F7F,
+			wantB: 0x8000,
+			wantA: 0xFFFF,
+		},
+		{
+			name: "hue_below_minus_360",
+			fields: fields{
+				H: -400.0,
+				S: 1.0,
+				L: 0.5,
+				A: 1.0,
+			},
+			wantR: 0xFFFF,
+			wantB: 0xAAAA,
+			wantA: 0xFFFF,
+		},
+		{
+			name: "hue_minus_720",
+			fields: fields{
+				H: -720.0,
+				S: 1.0,
+				L: 0.5,
+				A: 1.0,
+			},
+			wantR: 0xFFFF,
+			wantA: 0xFFFF,
+		},
+		{
+			name: "blue_alpha",
+			fields: fields{
+				H: 240.0,
+				S: 1.0,
+				L: 0.5,
+				A: 0.5,
+			},
+			wantB: 0x8000,
+			wantA: 0x8000,
 		},
 	}
 	for _, tt := range tests {
@@ -411,6 +507,7 @@ func TestHSLA_RGBA(t *testing.T) {
 				H: tt.fields.H,
 				S: tt.fields.S,
 				L: tt.fields.L,
+				A: tt.fields.A,
 			}
 			gotR, gotG, gotB, gotA := hsla.RGBA()
 			if gotR != tt.wantR {
@@ -429,6 +526,68 @@ func TestHSLA_RGBA(t *testing.T) {
 	}
 }
 
+func TestRGBA64ToHSLA(t *testing.T) {
+	type args struct {
+		r uint16
+		g uint16
+		b uint16
+		a uint16
+	}
+	tests := []struct {
+		name  string
+		args  args
+		wantH float64
+		wantS float64
+		wantL float64
+		wantA float64
+	}{
+		{
+			name:  "white",
+			args:  args{r: 0xFFFF, g: 0xFFFF, b: 0xFFFF, a: 0xFFFF},
+			wantL: 1.0,
+			wantA: 1.0,
+		},
+		{
+			name:  "dark_red",
+			args:  args{r: 0x0101, a: 0xFFFF},
+			wantS: 1.0,
+			wantL: 0.5 / 0xFF,
+			wantA: 1.0,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			gotH, gotS, gotL, gotA := RGBA64ToHSLA(tt.args.r, tt.args.g, tt.args.b, tt.args.a)
+			if !mathx.Equal(gotH, tt.wantH) {
+				t.Errorf("RGBA64ToHSLA() got H = %f, want %f", gotH, tt.wantH)
+			}
+			if !mathx.Equal(gotS, tt.wantS) {
+				t.Errorf("RGBA64ToHSLA() got S = %f, want %f", gotS, tt.wantS)
+			}
+			if !mathx.Equal(gotL, tt.wantL) {
+				t.Errorf("RGBA64ToHSLA() got L = %f, want %f", gotL, tt.wantL)
+			}
+			if !mathx.Equal(gotA, tt.wantA) {
+				t.Errorf("RGBA64ToHSLA() got A = %f, want %f", gotA, tt.wantA)
+			}
+		})
+	}
+}
+
+func TestHSLAModel_16bit(t *testing.T) {
+	colors := []color.RGBA64{
+		{R: 0x1234, G: 0x5678, B: 0x9ABC, A: 0xFFFF},
+		{R: 0x0001, G: 0x0002, B: 0x0003, A: 0xFFFF},
+		{R: 0xFFFE, G: 0x8001, B: 0x7FFF, A: 0xFFFF},
+	}
+	for _, c := range colors {
+		gotR, gotG, gotB, gotA := HSLAModel.Convert(c).RGBA()
+		if gotR != uint32(c.R) || gotG != uint32(c.G) || gotB != uint32(c.B) || gotA != uint32(c.A) {
+			t.Errorf("HSLAModel.Convert(%v).RGBA() = %04x, %04x, %04x, %04x", c, gotR, gotG, gotB, gotA)
+		}
+	}
+}
+
 func BenchmarkRGBToHSLA(b *testing.B) {
 	var red uint8 = 0xBF
 	var green uint8 = 0x0F
@@ -450,3 +609,206 @@ func BenchmarkHSL_RGBA(b *testing.B) {
 		hsl.RGBA()
 	}
 }
+
+func BenchmarkHSLA_AppendText(b *testing.B) {
+	c := HSLA{H: 200.5, S: 0.75, L: 0.4, A: 0.5}
+	buf := make([]byte, 0, 64)
+
+	b.ReportAllocs()
+	for n := 0; n < b.N; n++ {
+		buf = c.AppendText(buf[:0])
+	}
+}
+
+func TestHSLA_Adjust(t *testing.T) {
+	red := HSLA{S: 1.0, L: 0.5, A: 1.0}
+
+	tests := []struct {
+		name string
+		got  HSLA
+		want HSLA
+	}{
+		{
+			name: "rotate",
+			got:  red.Rotate(120.0),
+			want: HSLA{H: 120.0, S: 1.0, L: 0.5, A: 1.0},
+		},
+		{
+			name: "rotate_wrap",
+			got:  HSLA{H: 300.0, S: 1.0, L: 0.5, A: 1.0}.Rotate(90.0),
+			want: HSLA{H: 30.0, S: 1.0, L: 0.5, A: 1.0},
+		},
+		{
+			name: "rotate_negative",
+			got:  red.Rotate(-30.0),
+			want: HSLA{H: 330.0, S: 1.0, L: 0.5, A: 1.0},
+		},
+		{
+			name: "complement",
+			got:  red.Complement(),
+			want: HSLA{H: 180.0, S: 1.0, L: 0.5, A: 1.0},
+		},
+		{
+			name: "desaturate",
+			got:  red.Desaturate(0.25),
+			want: HSLA{S: 0.75, L: 0.5, A: 1.0},
+		},
+		{
+			name: "saturate_clamped",
+			got:  red.Saturate(0.5),
+			want: HSLA{S: 1.0, L: 0.5, A: 1.0},
+		},
+		{
+			name: "scale_saturation",
+			got:  red.ScaleSaturation(0.5),
+			want: HSLA{S: 0.5, L: 0.5, A: 1.0},
+		},
+		{
+			name: "lighten",
+			got:  red.Lighten(0.25),
+			want: HSLA{S: 1.0, L: 0.75, A: 1.0},
+		},
+		{
+			name: "darken_clamped",
+			got:  red.Darken(0.75),
+			want: HSLA{S: 1.0, A: 1.0},
+		},
+		{
+			name: "grayscale",
+			got:  HSLA{H: 200.0, S: 0.8, L: 0.3, A: 1.0}.Grayscale(),
+			want: HSLA{H: 200.0, L: 0.3, A: 1.0},
+		},
+		{
+			name: "invert",
+			got:  HSLA{H: 200.0, S: 0.8, L: 0.3, A: 1.0}.Invert(),
+			want: HSLA{H: 20.0, S: 0.8, L: 0.7, A: 1.0},
+		},
+		{
+			name: "with_alpha",
+			got:  red.WithAlpha(0.5),
+			want: HSLA{S: 1.0, L: 0.5, A: 0.5},
+		},
+		{
+			name: "with_alpha_clamped",
+			got:  red.WithAlpha(-1.0),
+			want: HSLA{S: 1.0, L: 0.5},
+		},
+		{
+			name: "clamp",
+			got:  HSLA{H: 720.0, S: 2.0, L: -1.0, A: 1.5}.Clamp(),
+			want: HSLA{S: 1.0, A: 1.0},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if !mathx.Equal(tt.got.H, tt.want.H) || !mathx.Equal(tt.got.S, tt.want.S) ||
+				!mathx.Equal(tt.got.L, tt.want.L) || !mathx.Equal(tt.got.A, tt.want.A) {
+				t.Errorf("got %+v, want %+v", tt.got, tt.want)
+			}
+		})
+	}
+}
+
+func TestHSLA_MarkPowerless(t *testing.T) {
+	tests := []struct {
+		name    string
+		hsla    HSLA
+		missing bool
+	}{
+		{
+			name:    "gray",
+			hsla:    HSLAModel.Convert(color.Gray{Y: 0x80}).(HSLA),
+			missing: true,
+		},
+		{
+			name: "red",
+			hsla: HSLA{S: 1.0, L: 0.5, A: 1.0},
+		},
+		{
+			name: "black_saturated",
+			hsla: HSLA{H: 120.0, S: 1.0, A: 1.0},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := tt.hsla.MarkPowerless()
+			if math.IsNaN(got.H) != tt.missing {
+				t.Errorf("MarkPowerless() = %+v, want missing hue %v", got, tt.missing)
+			}
+			if !mathx.Equal(got.S, tt.hsla.S) || !mathx.Equal(got.L, tt.hsla.L) || !mathx.Equal(got.A, tt.hsla.A) {
+				t.Errorf("MarkPowerless() = %+v, want %+v with missing hue", got, tt.hsla)
+			}
+		})
+	}
+}
+
+func TestHSLA_missing(t *testing.T) {
+	nan := math.NaN()
+
+	tests := []struct {
+		name string
+		hsla HSLA
+		want color.Color
+	}{
+		{
+			name: "hue",
+			hsla: HSLA{H: nan, S: 1.0, L: 0.5, A: 1.0},
+			want: HSLA{S: 1.0, L: 0.5, A: 1.0},
+		},
+		{
+			name: "saturation",
+			hsla: HSLA{H: 120.0, S: nan, L: 0.5, A: 1.0},
+			want: HSLA{H: 120.0, L: 0.5, A: 1.0},
+		},
+		{
+			name: "alpha",
+			hsla: HSLA{H: 120.0, S: 1.0, L: 0.5, A: nan},
+			want: color.Transparent,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r, g, b, a := tt.hsla.RGBA()
+			wr, wg, wb, wa := tt.want.RGBA()
+			if r != wr || g != wg || b != wb || a != wa {
+				t.Errorf("RGBA() = %d, %d, %d, %d, want %d, %d, %d, %d", r, g, b, a, wr, wg, wb, wa)
+			}
+
+			// Adjustments keep the component missing.
+			if got := tt.hsla.Clamp(); got.String() != tt.hsla.String() {
+				t.Errorf("Clamp() = %v, want %v", got, tt.hsla)
+			}
+		})
+	}
+}
+
+func TestHSLA_String(t *testing.T) {
+	tests := []struct {
+		name string
+		hsla HSLA
+		want string
+	}{
+		{
+			name: "opaque",
+			hsla: HSLA{H: 120.0, S: 0.5, L: 0.25, A: 1.0},
+			want: "hsl(120 50% 25%)",
+		},
+		{
+			name: "alpha",
+			hsla: HSLA{H: 200.5, S: 1.0, L: 0.5, A: 0.8},
+			want: "hsl(200.5 100% 50% / 0.8)",
+		},
+		{
+			name: "missing",
+			hsla: HSLA{H: math.NaN(), L: 0.5, A: math.NaN()},
+			want: "hsl(none 0% 50% / none)",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.hsla.String(); got != tt.want {
+				t.Errorf("String() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -8,7 +8,8 @@ import (
 )
 
 // HSVA is an implementation of the HSV (Hue, Saturation and Value) color model. HSV is also known as HSB (Hue,
-// Saturation, Brightness).
+// Saturation, Brightness). A component can be NaN to mark it as missing, like the CSS keyword "none". A missing
+// component converts as zero, but Mix takes the value of the other color instead.
 type HSVA struct {
 	H float64 // Hue ∈ [0, 360)
 	S float64 // Saturation ∈ [0, 1]
@@ -24,25 +25,35 @@ func hsvaModel(c color.Color) color.Color {
 		return c
 	}
 
-	r, g, b, a := c.RGBA()
-	h, s, v, ha := RGBAToHSVA(uint8(r>>8), uint8(g>>8), uint8(b>>8), uint8(a>>8))
+	// The channels of color.Color are alpha-premultiplied, HSVA is not.
+	r, g, b, a := toSRGB(c)
+	h, s, v := rgbToHSV(r, g, b)
 	return HSVA{
 		H: h,
 		S: s,
 		V: v,
-		A: ha,
+		A: a,
 	}
 }
 
-// RGBAToHSVA converts RGBA to Hue, Saturation, Value and Alpha.
+// RGBAToHSVA converts RGBA to Hue, Saturation, Value and Alpha. The hue of a gray is zero, use HSVA.MarkPowerless to
+// mark it as missing.
 func RGBAToHSVA(r, g, b, a uint8) (float64, float64, float64, float64) {
-	var hue, saturation, value, alpha float64
+	h, s, v := rgbToHSV(float64(r)/math.MaxUint8, float64(g)/math.MaxUint8, float64(b)/math.MaxUint8)
 
-	// Convert R, G and B to floats.
-	red := float64(r) / math.MaxUint8
-	green := float64(g) / math.MaxUint8
-	blue := float64(b) / math.MaxUint8
-	alpha = float64(a) / math.MaxUint8
+	return h, s, v, float64(a) / math.MaxUint8
+}
+
+// RGBA64ToHSVA converts 16-bit RGBA to Hue, Saturation, Value and Alpha.
+func RGBA64ToHSVA(r, g, b, a uint16) (float64, float64, float64, float64) {
+	h, s, v := rgbToHSV(float64(r)/math.MaxUint16, float64(g)/math.MaxUint16, float64(b)/math.MaxUint16)
+
+	return h, s, v, float64(a) / math.MaxUint16
+}
+
+// rgbToHSV converts red, green and blue ∈ [0, 1] to hue, saturation and value.
+func rgbToHSV(red, green, blue float64) (float64, float64, float64) {
+	var hue, saturation, value float64
 
 	// Get the most and least dominant colors.
 	cMax := math.Max(red, math.Max(green, blue))
@@ -76,62 +87,184 @@ func RGBAToHSVA(r, g, b, a uint8) (float64, float64, float64, float64) {
 
 	hue = math.Mod(hue, 360.0)
 
-	return hue, saturation, value, alpha
+	return hue, saturation, value
 }
 
 // RGBA returns the alpha-premultiplied red, green, blue and alpha values for the color.
 func (hsva HSVA) RGBA() (r, g, b, a uint32) {
-	var rgba color.RGBA
+	red, green, blue := hsvToRGB(orZero(hsva.H), orZero(hsva.S), orZero(hsva.V))
+
+	return premultiply(red, green, blue, hsva.A)
+}
+
+func (hsva HSVA) linear() (r, g, b, a float64) {
+	return linearizeRGB(hsva.srgb())
+}
+
+func (hsva HSVA) srgb() (r, g, b, a float64) {
+	r, g, b = hsvToRGB(orZero(hsva.H), orZero(hsva.S), orZero(hsva.V))
+
+	return r, g, b, orZero(hsva.A)
+}
+
+// String returns the color in the CSS hsl() function, such as "hsl(120 50% 25% / 0.8)", since CSS has no function for
+// HSV. The conversion to HSL is exact. A missing component is written as "none".
+func (hsva HSVA) String() string {
+	return string(hsva.AppendText(nil))
+}
+
+// AppendText appends the color in the CSS hsl() function, like String, to dst and returns the extended buffer. It does
+// not allocate if dst has room for the text.
+func (hsva HSVA) AppendText(dst []byte) []byte {
+	return hsva.hsla().AppendText(dst)
+}
+
+// hsla converts the color to HSLA without going through RGB.
+func (hsva HSVA) hsla() HSLA {
+	l := hsva.V * (1.0 - hsva.S/2.0)
+
+	s := math.NaN()
+	if !math.IsNaN(l) {
+		s = 0
+		if m := math.Min(l, 1.0-l); m > 0 {
+			s = (hsva.V - l) / m
+		}
+	}
 
-	rgba.A = uint8(hsva.A * math.MaxUint8)
+	return HSLA{H: hsva.H, S: s, L: l, A: hsva.A}
+}
 
-	if mathx.Equal(hsva.S, 0.0) {
-		rgba.R = uint8(hsva.V * math.MaxUint8)
-		rgba.G = uint8(hsva.V * math.MaxUint8)
-		rgba.B = uint8(hsva.V * math.MaxUint8)
-		return rgba.RGBA()
+// MarkPowerless returns the color with the hue marked as missing if it is powerless, because the color is gray or
+// black.
+func (hsva HSVA) MarkPowerless() HSVA {
+	if SpaceHSV.powerless([3]float64{hsva.H, hsva.S, hsva.V}) {
+		hsva.H = math.NaN()
 	}
 
-	angle := math.Mod(hsva.H+360.0, 360.0)
+	return hsva
+}
+
+// hsvToRGB converts hue, saturation and value to red, green and blue, all channels ∈ [0, 1].
+func hsvToRGB(h, s, v float64) (float64, float64, float64) {
+	if mathx.Equal(s, 0.0) {
+		return v, v, v
+	}
+
+	angle := normalizeHue(h)
 
 	// sextant will be the sextant of the dominant color.
 	sextant, frac := math.Modf(angle / 60.0)
 
-	p := hsva.V * (1.0 - hsva.S)
-	q := hsva.V * (1.0 - (hsva.S * frac))
-	t := hsva.V * (1.0 - (hsva.S * (1.0 - frac)))
+	p := v * (1.0 - s)
+	q := v * (1.0 - (s * frac))
+	t := v * (1.0 - (s * (1.0 - frac)))
 
 	switch sextant {
 	case 0:
-		rgba.R = uint8(math.Floor(hsva.V * math.MaxUint8))
-		rgba.G = uint8(math.Floor(t * math.MaxUint8))
-		rgba.B = uint8(math.Floor(p * math.MaxUint8))
+		return v, t, p
 
 	case 1:
-		rgba.R = uint8(math.Floor(q * math.MaxUint8))
-		rgba.G = uint8(math.Floor(hsva.V * math.MaxUint8))
-		rgba.B = uint8(math.Floor(p * math.MaxUint8))
+		return q, v, p
 
 	case 2:
-		rgba.R = uint8(math.Floor(p * math.MaxUint8))
-		rgba.G = uint8(math.Floor(hsva.V * math.MaxUint8))
-		rgba.B = uint8(math.Floor(t * math.MaxUint8))
+		return p, v, t
 
 	case 3:
-		rgba.R = uint8(math.Floor(p * math.MaxUint8))
-		rgba.G = uint8(math.Floor(q * math.MaxUint8))
-		rgba.B = uint8(math.Floor(hsva.V * math.MaxUint8))
+		return p, q, v
 
 	case 4:
-		rgba.R = uint8(math.Floor(t * math.MaxUint8))
-		rgba.G = uint8(math.Floor(p * math.MaxUint8))
-		rgba.B = uint8(math.Floor(hsva.V * math.MaxUint8))
+		return t, p, v
 
 	default: // case 5
-		rgba.R = uint8(math.Floor(hsva.V * math.MaxUint8))
-		rgba.G = uint8(math.Floor(p * math.MaxUint8))
-		rgba.B = uint8(math.Floor(q * math.MaxUint8))
+		return v, p, q
 	}
+}
+
+// Clamp returns the color with the hue wrapped around to [0, 360) and the other components clamped to [0, 1]. Missing
+// components stay missing.
+func (hsva HSVA) Clamp() HSVA {
+	return HSVA{
+		H: normalizeHue(hsva.H),
+		S: clampPresent(hsva.S, 0, 1),
+		V: clampPresent(hsva.V, 0, 1),
+		A: clampPresent(hsva.A, 0, 1),
+	}
+}
+
+// Rotate returns the color with the hue rotated by deg degrees. Negative degrees rotate the other way.
+func (hsva HSVA) Rotate(deg float64) HSVA {
+	hsva.H += deg
+
+	return hsva.Clamp()
+}
+
+// Complement returns the complementary color, the color with the hue rotated by 180 degrees.
+func (hsva HSVA) Complement() HSVA {
+	return hsva.Rotate(180.0)
+}
+
+// Saturate returns the color with amount added to the saturation. A negative amount desaturates.
+func (hsva HSVA) Saturate(amount float64) HSVA {
+	hsva.S += amount
+
+	return hsva.Clamp()
+}
+
+// Desaturate returns the color with amount subtracted from the saturation.
+func (hsva HSVA) Desaturate(amount float64) HSVA {
+	return hsva.Saturate(-amount)
+}
+
+// ScaleSaturation returns the color with the saturation multiplied by factor.
+func (hsva HSVA) ScaleSaturation(factor float64) HSVA {
+	hsva.S *= factor
+
+	return hsva.Clamp()
+}
+
+// Lighten returns the color with amount added to the value. A negative amount darkens.
+func (hsva HSVA) Lighten(amount float64) HSVA {
+	hsva.V += amount
+
+	return hsva.Clamp()
+}
+
+// Darken returns the color with amount subtracted from the value.
+func (hsva HSVA) Darken(amount float64) HSVA {
+	return hsva.Lighten(-amount)
+}
+
+// Grayscale returns the color desaturated to gray. The hue is kept so that the color can be saturated again.
+func (hsva HSVA) Grayscale() HSVA {
+	hsva.S = 0
+
+	return hsva.Clamp()
+}
+
+// Invert returns the negative of the color, the color with each of the red, green and blue channels inverted.
+func (hsva HSVA) Invert() HSVA {
+	hsva = hsva.Clamp()
+
+	// The brightest channel of the negative is the inverse of the darkest channel, the chroma is unchanged.
+	chroma := hsva.V * hsva.S
+	value := 1.0 - hsva.V + chroma
+
+	var saturation float64
+	if value > 0 {
+		saturation = chroma / value
+	}
+
+	return HSVA{
+		H: normalizeHue(hsva.H + 180.0),
+		S: saturation,
+		V: value,
+		A: hsva.A,
+	}
+}
+
+// WithAlpha returns the color with the alpha replaced.
+func (hsva HSVA) WithAlpha(alpha float64) HSVA {
+	hsva.A = alpha
 
-	return rgba.RGBA()
+	return hsva.Clamp()
 }
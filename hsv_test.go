@@ -2,6 +2,7 @@ package colorx
 
 import (
 	"image/color"
+	"math"
 	"testing"
 
 	"github.com/somebadcode/go-colorx/v2/internal/mathx"
@@ -19,16 +20,37 @@ func TestHSVAModel(t *testing.T) {
 		{
 			name: "rgba",
 			args: args{
-				c: color.RGBA{R: 0x80, G: 0x80},
+				c: color.RGBA{R: 0x80, G: 0x80, A: 0xFF},
 			},
-			want: HSVA{H: 60.0, S: 1.0, V: 0.5},
+			want: HSVA{H: 60.0, S: 1.0, V: 0.5, A: 1.0},
+		},
+		{
+			name: "premultiplied",
+			args: args{
+				c: color.RGBA{R: 0x40, G: 0x40, A: 0x80},
+			},
+			want: HSVA{H: 60.0, S: 1.0, V: 0.5, A: 0.5},
+		},
+		{
+			name: "nrgba",
+			args: args{
+				c: color.NRGBA{R: 0xFF, A: 0x80},
+			},
+			want: HSVA{S: 1.0, V: 1.0, A: 0.5},
+		},
+		{
+			name: "nrgba64",
+			args: args{
+				c: color.NRGBA64{B: 0xFFFF, A: 0x8000},
+			},
+			want: HSVA{H: 240.0, S: 1.0, V: 1.0, A: 0.5},
 		},
 		{
 			name: "hsva",
 			args: args{
-				c: HSVA{H: 60.0, S: 1.0, V: 0.5},
+				c: HSVA{H: 60.0, S: 1.0, V: 0.5, A: 1.0},
 			},
-			want: HSVA{H: 60.0, S: 1.0, V: 0.5},
+			want: HSVA{H: 60.0, S: 1.0, V: 0.5, A: 1.0},
 		},
 	}
 	for _, tt := range tests {
@@ -47,6 +69,9 @@ func TestHSVAModel(t *testing.T) {
 			if !mathx.EqualP(got.V, tt.want.V, 1e-2) {
 				t.Errorf("HSVAModel.Convert() got V = %f, want %f", got.V, tt.want.V)
 			}
+			if !mathx.EqualP(got.A, tt.want.A, 1e-2) {
+				t.Errorf("HSVAModel.Convert() got A = %f, want %f", got.A, tt.want.A)
+			}
 		})
 	}
 }
@@ -205,24 +230,29 @@ func TestHSV_RGBA(t *testing.T) {
 	}{
 		{
 			name:   "black",
-			fields: fields{},
+			fields: fields{A: 1.0},
+			wantA:  0xFFFF,
 		},
 		{
 			name: "white",
 			fields: fields{
 				V: 1.0,
+				A: 1.0,
 			},
 			wantR: 0xFFFF,
 			wantG: 0xFFFF,
 			wantB: 0xFFFF,
+			wantA: 0xFFFF,
 		},
 		{
 			name: "red",
 			fields: fields{
 				S: 1.0,
 				V: 1.0,
+				A: 1.0,
 			},
 			wantR: 0xFFFF,
+			wantA: 0xFFFF,
 		},
 		{
 			name: "lime",
@@ -230,8 +260,10 @@ func TestHSV_RGBA(t *testing.T) {
 				H: 120.0,
 				S: 1.0,
 				V: 1.0,
+				A: 1.0,
 			},
 			wantG: 0xFFFF,
+			wantA: 0xFFFF,
 		},
 		{
 			name: "blue",
@@ -239,8 +271,10 @@ func TestHSV_RGBA(t *testing.T) {
 				H: 240.0,
 				S: 1.0,
 				V: 1.0,
+				A: 1.0,
 			},
 			wantB: 0xFFFF,
+			wantA: 0xFFFF,
 		},
 		{
 			name: "yellow",
@@ -248,9 +282,11 @@ func TestHSV_RGBA(t *testing.T) {
 				H: 60.0,
 				S: 1.0,
 				V: 1.0,
+				A: 1.0,
 			},
 			wantR: 0xFFFF,
 			wantG: 0xFFFF,
+			wantA: 0xFFFF,
 		},
 		{
 			name: "cyan",
@@ -258,9 +294,11 @@ func TestHSV_RGBA(t *testing.T) {
 				H: 180.0,
 				S: 1.0,
 				V: 1.0,
+				A: 1.0,
 			},
 			wantG: 0xFFFF,
 			wantB: 0xFFFF,
+			wantA: 0xFFFF,
 		},
 		{
 			name: "cyan_negative",
@@ -268,9 +306,11 @@ func TestHSV_RGBA(t *testing.T) {
 				H: -180.0,
 				S: 1.0,
 				V: 1.0,
+				A: 1.0,
 			},
 			wantG: 0xFFFF,
 			wantB: 0xFFFF,
+			wantA: 0xFFFF,
 		},
 		{
 			name: "magenta",
@@ -278,9 +318,11 @@ func TestHSV_RGBA(t *testing.T) {
 				H: 300.0,
 				S: 1.0,
 				V: 1.0,
+				A: 1.0,
 			},
 			wantR: 0xFFFF,
 			wantB: 0xFFFF,
+			wantA: 0xFFFF,
 		},
 		{
 			name: "magenta_negative",
@@ -288,35 +330,43 @@ func TestHSV_RGBA(t *testing.T) {
 				H: -60.0,
 				S: 1.0,
 				V: 1.0,
+				A: 1.0,
 			},
 			wantR: 0xFFFF,
 			wantB: 0xFFFF,
+			wantA: 0xFFFF,
 		},
 		{
 			name: "silver",
 			fields: fields{
 				V: 0.75,
+				A: 1.0,
 			},
-			wantR: 0xBFBF,
-			wantG: 0xBFBF,
-			wantB: 0xBFBF,
+			wantR: 0xBFFF,
+			wantG: 0xBFFF,
+			wantB: 0xBFFF,
+			wantA: 0xFFFF,
 		},
 		{
 			name: "gray",
 			fields: fields{
 				V: 0.5,
+				A: 1.0,
 			},
-			wantR: 0x7F7F,
-			wantG: 0x7F7F,
-			wantB: 0x7F7F,
+			wantR: 0x8000,
+			wantG: 0x8000,
+			wantB: 0x8000,
+			wantA: 0xFFFF,
 		},
 		{
 			name: "maroon",
 			fields: fields{
 				S: 1.0,
 				V: 0.5,
+				A: 1.0,
 			},
-			wantR: 0x7F7F,
+			wantR: 0x8000,
+			wantA: 0xFFFF,
 		},
 		{
 			name: "olive",
@@ -324,9 +374,11 @@ func TestHSV_RGBA(t *testing.T) {
 				H: 60.0,
 				S: 1.0,
 				V: 0.5,
+				A: 1.0,
 			},
-			wantR: 0x7F7F,
-			wantG: 0x7F7F,
+			wantR: 0x8000,
+			wantG: 0x8000,
+			wantA: 0xFFFF,
 		},
 		{
 			name: "green",
@@ -334,8 +386,10 @@ func TestHSV_RGBA(t *testing.T) {
 				H: 120.0,
 				S: 1.0,
 				V: 0.5,
+				A: 1.0,
 			},
-			wantG: 0x7F7F,
+			wantG: 0x8000,
+			wantA: 0xFFFF,
 		},
 		{
 			name: "purple",
@@ -343,9 +397,11 @@ func TestHSV_RGBA(t *testing.T) {
 				H: 300.0,
 				S: 1.0,
 				V: 0.5,
+				A: 1.0,
 			},
-			wantR: 0x7F7F,
-			wantB: 0x7F7F,
+			wantR: 0x8000,
+			wantB: 0x8000,
+			wantA: 0xFFFF,
 		},
 		{
 			name: "teal",
@@ -353,9 +409,11 @@ func TestHSV_RGBA(t *testing.T) {
 				H: 180.0,
 				S: 1.0,
 				V: 0.5,
+				A: 1.0,
 			},
-			wantG: 0x7F7F,
-			wantB: 0x7F7F,
+			wantG: 0x8000,
+			wantB: 0x8000,
+			wantA: 0xFFFF,
 		},
 		{
 			name: "navy",
@@ -363,8 +421,44 @@ func TestHSV_RGBA(t *testing.T) {
 				H: 240.0,
 				S: 1.0,
 				V: 0.5,
+				A: 1.0,
+			},
+			wantB: 0x8000,
+			wantA: 0xFFFF,
+		},
+		{
+			name: "hue_below_minus_360",
+			fields: fields{
+				H: -400.0,
+				S: 1.0,
+				V: 1.0,
+				A: 1.0,
+			},
+			wantR: 0xFFFF,
+			wantB: 0xAAAA,
+			wantA: 0xFFFF,
+		},
+		{
+			name: "hue_minus_720",
+			fields: fields{
+				H: -720.0,
+				S: 1.0,
+				V: 1.0,
+				A: 1.0,
 			},
-			wantB: 0x7F7F,
+			wantR: 0xFFFF,
+			wantA: 0xFFFF,
+		},
+		{
+			name: "blue_alpha",
+			fields: fields{
+				H: 240.0,
+				S: 1.0,
+				V: 1.0,
+				A: 0.5,
+			},
+			wantB: 0x8000,
+			wantA: 0x8000,
 		},
 	}
 	for _, tt := range tests {
@@ -373,6 +467,7 @@ func TestHSV_RGBA(t *testing.T) {
 				H: tt.fields.H,
 				S: tt.fields.S,
 				V: tt.fields.V,
+				A: tt.fields.A,
 			}
 			gotR, gotG, gotB, gotA := hsv.RGBA()
 			if gotR != tt.wantR {
@@ -391,6 +486,68 @@ func TestHSV_RGBA(t *testing.T) {
 	}
 }
 
+func TestRGBA64ToHSVA(t *testing.T) {
+	type args struct {
+		r uint16
+		g uint16
+		b uint16
+		a uint16
+	}
+	tests := []struct {
+		name  string
+		args  args
+		wantH float64
+		wantS float64
+		wantV float64
+		wantA float64
+	}{
+		{
+			name:  "white",
+			args:  args{r: 0xFFFF, g: 0xFFFF, b: 0xFFFF, a: 0xFFFF},
+			wantV: 1.0,
+			wantA: 1.0,
+		},
+		{
+			name:  "dark_red",
+			args:  args{r: 0x0101, a: 0xFFFF},
+			wantS: 1.0,
+			wantV: 1.0 / 0xFF,
+			wantA: 1.0,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			gotH, gotS, gotV, gotA := RGBA64ToHSVA(tt.args.r, tt.args.g, tt.args.b, tt.args.a)
+			if !mathx.Equal(gotH, tt.wantH) {
+				t.Errorf("RGBA64ToHSVA() got H = %f, want %f", gotH, tt.wantH)
+			}
+			if !mathx.Equal(gotS, tt.wantS) {
+				t.Errorf("RGBA64ToHSVA() got S = %f, want %f", gotS, tt.wantS)
+			}
+			if !mathx.Equal(gotV, tt.wantV) {
+				t.Errorf("RGBA64ToHSVA() got V = %f, want %f", gotV, tt.wantV)
+			}
+			if !mathx.Equal(gotA, tt.wantA) {
+				t.Errorf("RGBA64ToHSVA() got A = %f, want %f", gotA, tt.wantA)
+			}
+		})
+	}
+}
+
+func TestHSVAModel_16bit(t *testing.T) {
+	colors := []color.RGBA64{
+		{R: 0x1234, G: 0x5678, B: 0x9ABC, A: 0xFFFF},
+		{R: 0x0001, G: 0x0002, B: 0x0003, A: 0xFFFF},
+		{R: 0xFFFE, G: 0x8001, B: 0x7FFF, A: 0xFFFF},
+	}
+	for _, c := range colors {
+		gotR, gotG, gotB, gotA := HSVAModel.Convert(c).RGBA()
+		if gotR != uint32(c.R) || gotG != uint32(c.G) || gotB != uint32(c.B) || gotA != uint32(c.A) {
+			t.Errorf("HSVAModel.Convert(%v).RGBA() = %04x, %04x, %04x, %04x", c, gotR, gotG, gotB, gotA)
+		}
+	}
+}
+
 func BenchmarkRGBToHSV(b *testing.B) {
 	var red uint8 = 0xBF
 	var green uint8 = 0x0F
@@ -412,3 +569,215 @@ func BenchmarkHSV_RGBA(b *testing.B) {
 		hsv.RGBA()
 	}
 }
+
+func BenchmarkHSVA_AppendText(b *testing.B) {
+	c := HSVA{H: 200.5, S: 0.75, V: 0.4, A: 0.5}
+	buf := make([]byte, 0, 64)
+
+	b.ReportAllocs()
+	for n := 0; n < b.N; n++ {
+		buf = c.AppendText(buf[:0])
+	}
+}
+
+func TestHSVA_Adjust(t *testing.T) {
+	red := HSVA{S: 1.0, V: 1.0, A: 1.0}
+
+	tests := []struct {
+		name string
+		got  HSVA
+		want HSVA
+	}{
+		{
+			name: "rotate",
+			got:  red.Rotate(120.0),
+			want: HSVA{H: 120.0, S: 1.0, V: 1.0, A: 1.0},
+		},
+		{
+			name: "rotate_wrap",
+			got:  HSVA{H: 300.0, S: 1.0, V: 1.0, A: 1.0}.Rotate(90.0),
+			want: HSVA{H: 30.0, S: 1.0, V: 1.0, A: 1.0},
+		},
+		{
+			name: "rotate_negative",
+			got:  red.Rotate(-30.0),
+			want: HSVA{H: 330.0, S: 1.0, V: 1.0, A: 1.0},
+		},
+		{
+			name: "complement",
+			got:  red.Complement(),
+			want: HSVA{H: 180.0, S: 1.0, V: 1.0, A: 1.0},
+		},
+		{
+			name: "desaturate",
+			got:  red.Desaturate(0.25),
+			want: HSVA{S: 0.75, V: 1.0, A: 1.0},
+		},
+		{
+			name: "saturate_clamped",
+			got:  red.Saturate(0.5),
+			want: HSVA{S: 1.0, V: 1.0, A: 1.0},
+		},
+		{
+			name: "scale_saturation",
+			got:  red.ScaleSaturation(0.5),
+			want: HSVA{S: 0.5, V: 1.0, A: 1.0},
+		},
+		{
+			name: "lighten_clamped",
+			got:  red.Lighten(0.25),
+			want: HSVA{S: 1.0, V: 1.0, A: 1.0},
+		},
+		{
+			name: "darken",
+			got:  red.Darken(0.75),
+			want: HSVA{S: 1.0, V: 0.25, A: 1.0},
+		},
+		{
+			name: "grayscale",
+			got:  HSVA{H: 200.0, S: 0.8, V: 0.3, A: 1.0}.Grayscale(),
+			want: HSVA{H: 200.0, V: 0.3, A: 1.0},
+		},
+		{
+			name: "invert",
+			got:  HSVA{H: 200.0, S: 0.5, V: 0.8, A: 1.0}.Invert(),
+			want: HSVA{H: 20.0, S: 0.4 / 0.6, V: 0.6, A: 1.0},
+		},
+		{
+			name: "invert_black",
+			got:  HSVA{A: 1.0}.Invert(),
+			want: HSVA{H: 180.0, V: 1.0, A: 1.0},
+		},
+		{
+			name: "with_alpha",
+			got:  red.WithAlpha(0.5),
+			want: HSVA{S: 1.0, V: 1.0, A: 0.5},
+		},
+		{
+			name: "clamp",
+			got:  HSVA{H: -360.0, S: 2.0, V: -1.0, A: 1.5}.Clamp(),
+			want: HSVA{S: 1.0, A: 1.0},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if !mathx.Equal(tt.got.H, tt.want.H) || !mathx.Equal(tt.got.S, tt.want.S) ||
+				!mathx.Equal(tt.got.V, tt.want.V) || !mathx.Equal(tt.got.A, tt.want.A) {
+				t.Errorf("got %+v, want %+v", tt.got, tt.want)
+			}
+		})
+	}
+}
+
+func TestHSVA_Adjust_sameAsHSLA(t *testing.T) {
+	hsva := HSVA{H: 200.0, S: 0.5, V: 0.8, A: 1.0}
+	hsla := HSLAModel.Convert(hsva).(HSLA)
+
+	tests := []struct {
+		name string
+		hsva color.Color
+		hsla color.Color
+	}{
+		{name: "rotate", hsva: hsva.Rotate(-75.0), hsla: hsla.Rotate(-75.0)},
+		{name: "complement", hsva: hsva.Complement(), hsla: hsla.Complement()},
+		{name: "invert", hsva: hsva.Invert(), hsla: hsla.Invert()},
+		{name: "with_alpha", hsva: hsva.WithAlpha(0.25), hsla: hsla.WithAlpha(0.25)},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r1, g1, b1, a1 := tt.hsva.RGBA()
+			r2, g2, b2, a2 := tt.hsla.RGBA()
+			if r1 != r2 || g1 != g2 || b1 != b2 || a1 != a2 {
+				t.Errorf("HSVA = %04x %04x %04x %04x, HSLA = %04x %04x %04x %04x", r1, g1, b1, a1, r2, g2, b2, a2)
+			}
+		})
+	}
+}
+
+func TestHSVA_MarkPowerless(t *testing.T) {
+	tests := []struct {
+		name    string
+		hsva    HSVA
+		missing bool
+	}{
+		{
+			name:    "gray",
+			hsva:    HSVAModel.Convert(color.Gray{Y: 0x80}).(HSVA),
+			missing: true,
+		},
+		{
+			name:    "black",
+			hsva:    HSVA{H: 120.0, S: 1.0, A: 1.0},
+			missing: true,
+		},
+		{
+			name: "red",
+			hsva: HSVA{S: 1.0, V: 1.0, A: 1.0},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := tt.hsva.MarkPowerless()
+			if math.IsNaN(got.H) != tt.missing {
+				t.Errorf("MarkPowerless() = %+v, want missing hue %v", got, tt.missing)
+			}
+
+			// A missing hue converts as zero.
+			r, g, b, a := got.RGBA()
+			wr, wg, wb, wa := HSVA{S: got.S, V: got.V, A: got.A}.RGBA()
+			if !tt.missing {
+				wr, wg, wb, wa = tt.hsva.RGBA()
+			}
+			if r != wr || g != wg || b != wb || a != wa {
+				t.Errorf("RGBA() = %d, %d, %d, %d, want %d, %d, %d, %d", r, g, b, a, wr, wg, wb, wa)
+			}
+		})
+	}
+}
+
+func TestHSVA_String(t *testing.T) {
+	tests := []struct {
+		name string
+		hsva HSVA
+		want string
+	}{
+		{
+			name: "red",
+			hsva: HSVA{S: 1.0, V: 1.0, A: 1.0},
+			want: "hsl(0 100% 50%)",
+		},
+		{
+			name: "pastel",
+			hsva: HSVA{H: 120.0, S: 0.5, V: 1.0, A: 0.5},
+			want: "hsl(120 100% 75% / 0.5)",
+		},
+		{
+			name: "white",
+			hsva: HSVA{V: 1.0, A: 1.0}.MarkPowerless(),
+			want: "hsl(none 0% 100%)",
+		},
+		{
+			name: "missing_value",
+			hsva: HSVA{H: 120.0, S: 0.5, V: math.NaN(), A: 1.0},
+			want: "hsl(120 none none)",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.hsva.String(); got != tt.want {
+				t.Errorf("String() = %v, want %v", got, tt.want)
+			}
+
+			// The hsl() function is the same color.
+			c, err := Parse(tt.hsva.String())
+			if err != nil {
+				t.Fatalf("Parse() error = %v", err)
+			}
+			r, g, b, a := c.RGBA()
+			wr, wg, wb, wa := CSSModel.Convert(tt.hsva).RGBA()
+			if r != wr || g != wg || b != wb || a != wa {
+				t.Errorf("Parse(%q) = %d, %d, %d, %d, want %d, %d, %d, %d", tt.hsva, r, g, b, a, wr, wg, wb, wa)
+			}
+		})
+	}
+}
@@ -0,0 +1,77 @@
+package colorx
+
+import (
+	"math"
+)
+
+// normalizeHue wraps a hue in degrees around to [0, 360).
+func normalizeHue(h float64) float64 {
+	h = math.Mod(h, 360.0)
+	if h < 0 {
+		h += 360.0
+	}
+
+	// Adding 360 to a tiny negative hue can round up to 360.
+	if h >= 360.0 {
+		return 0
+	}
+
+	return h
+}
+
+// HueInterpolation selects which way around the hue wheel hues are interpolated, like the hue-interpolation-method of
+// CSS.
+type HueInterpolation int
+
+const (
+	HueShorter    HueInterpolation = iota // Take the shorter arc, at most 180 degrees
+	HueLonger                             // Take the longer arc, at least 180 degrees
+	HueIncreasing                         // Rotate clockwise, with increasing hue
+	HueDecreasing                         // Rotate counterclockwise, with decreasing hue
+)
+
+// fixupHues adjusts two hues in degrees ∈ [0, 360) so that linear interpolation between them follows the method. A
+// missing hue (NaN) takes the value of the other one. If both are missing, they stay missing, and so does the
+// interpolated hue.
+func fixupHues(h1, h2 float64, method HueInterpolation) (float64, float64) {
+	switch {
+	case math.IsNaN(h1) && math.IsNaN(h2):
+		return math.NaN(), math.NaN()
+
+	case math.IsNaN(h1):
+		h1 = h2
+
+	case math.IsNaN(h2):
+		h2 = h1
+	}
+
+	diff := h2 - h1
+
+	switch method {
+	case HueShorter:
+		if diff > 180.0 {
+			h1 += 360.0
+		} else if diff < -180.0 {
+			h2 += 360.0
+		}
+
+	case HueLonger:
+		if diff > 0 && diff < 180.0 {
+			h1 += 360.0
+		} else if diff > -180.0 && diff <= 0 {
+			h2 += 360.0
+		}
+
+	case HueIncreasing:
+		if diff < 0 {
+			h2 += 360.0
+		}
+
+	case HueDecreasing:
+		if diff > 0 {
+			h1 += 360.0
+		}
+	}
+
+	return h1, h2
+}
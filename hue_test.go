@@ -0,0 +1,76 @@
+package colorx
+
+import (
+	"math"
+	"testing"
+)
+
+func TestNormalizeHue(t *testing.T) {
+	tests := []struct {
+		name string
+		h    float64
+		want float64
+	}{
+		{name: "in_range", h: 120.0, want: 120.0},
+		{name: "full_turn", h: 360.0, want: 0.0},
+		{name: "above", h: 725.0, want: 5.0},
+		{name: "negative", h: -90.0, want: 270.0},
+		{name: "tiny_negative", h: -1e-14, want: 0.0},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := normalizeHue(tt.h); got != tt.want {
+				t.Errorf("normalizeHue() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestFixupHues(t *testing.T) {
+	type args struct {
+		h1     float64
+		h2     float64
+		method HueInterpolation
+	}
+	tests := []struct {
+		name   string
+		args   args
+		wantH1 float64
+		wantH2 float64
+	}{
+		{name: "shorter", args: args{h1: 30.0, h2: 90.0, method: HueShorter}, wantH1: 30.0, wantH2: 90.0},
+		{name: "shorter_wrap", args: args{h1: 350.0, h2: 10.0, method: HueShorter}, wantH1: 350.0, wantH2: 370.0},
+		{
+			name:   "shorter_wrap_reverse",
+			args:   args{h1: 10.0, h2: 350.0, method: HueShorter},
+			wantH1: 370.0,
+			wantH2: 350.0,
+		},
+		{name: "longer", args: args{h1: 30.0, h2: 90.0, method: HueLonger}, wantH1: 390.0, wantH2: 90.0},
+		{name: "longer_reverse", args: args{h1: 90.0, h2: 30.0, method: HueLonger}, wantH1: 90.0, wantH2: 390.0},
+		{name: "longer_wrap", args: args{h1: 350.0, h2: 10.0, method: HueLonger}, wantH1: 350.0, wantH2: 10.0},
+		{name: "increasing", args: args{h1: 90.0, h2: 30.0, method: HueIncreasing}, wantH1: 90.0, wantH2: 390.0},
+		{name: "decreasing", args: args{h1: 30.0, h2: 90.0, method: HueDecreasing}, wantH1: 390.0, wantH2: 90.0},
+		{name: "missing_first", args: args{h1: math.NaN(), h2: 90.0, method: HueLonger}, wantH1: 90.0, wantH2: 450.0},
+		{name: "missing_second", args: args{h1: 30.0, h2: math.NaN(), method: HueShorter}, wantH1: 30.0, wantH2: 30.0},
+		{
+			name:   "missing_both",
+			args:   args{h1: math.NaN(), h2: math.NaN(), method: HueShorter},
+			wantH1: math.NaN(),
+			wantH2: math.NaN(),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			gotH1, gotH2 := fixupHues(tt.args.h1, tt.args.h2, tt.args.method)
+			if !sameHue(gotH1, tt.wantH1) || !sameHue(gotH2, tt.wantH2) {
+				t.Errorf("fixupHues() = %v, %v, want %v, %v", gotH1, gotH2, tt.wantH1, tt.wantH2)
+			}
+		})
+	}
+}
+
+// sameHue reports whether the hues are equal or both missing.
+func sameHue(a, b float64) bool {
+	return a == b || math.IsNaN(a) && math.IsNaN(b)
+}
@@ -0,0 +1,85 @@
+package colorx
+
+import (
+	"image/color"
+	"math"
+
+	"github.com/somebadcode/go-colorx/v2/internal/mathx"
+)
+
+// HWBA is an implementation of the HWB (Hue, Whiteness, Blackness) color model used by CSS. HWB describes a color as a
+// pure hue mixed with white and black, which is how painters mix tints and shades. A component can be NaN to mark it as
+// missing, like the CSS keyword "none", and converts as zero.
+type HWBA struct {
+	H float64 // Hue ∈ [0, 360)
+	W float64 // Whiteness ∈ [0, 1]
+	B float64 // Blackness ∈ [0, 1]
+	A float64 // Alpha ∈ [0, 1]
+}
+
+// HWBAModel can convert the color to the HWBA color model defined in this package.
+var HWBAModel = color.ModelFunc(hwbaModel)
+
+func hwbaModel(c color.Color) color.Color {
+	if _, ok := c.(HWBA); ok {
+		return c
+	}
+
+	r, g, b, a := toSRGB(c)
+	h, w, bl := rgbToHWB(r, g, b)
+
+	return HWBA{H: h, W: w, B: bl, A: a}
+}
+
+// RGBA returns the alpha-premultiplied red, green, blue and alpha values for the color.
+func (hwba HWBA) RGBA() (r, g, b, a uint32) {
+	red, green, blue := hwbToRGB(orZero(hwba.H), mathx.Clamp(hwba.W, 0, 1), mathx.Clamp(hwba.B, 0, 1))
+
+	return premultiply(red, green, blue, hwba.A)
+}
+
+func (hwba HWBA) linear() (r, g, b, a float64) {
+	return linearizeRGB(hwba.srgb())
+}
+
+func (hwba HWBA) srgb() (r, g, b, a float64) {
+	r, g, b = hwbToRGB(orZero(hwba.H), orZero(hwba.W), orZero(hwba.B))
+
+	return r, g, b, orZero(hwba.A)
+}
+
+// String returns the color in the CSS hwb() function, such as "hwb(120 20% 30% / 0.8)". A missing component is
+// written as "none".
+func (hwba HWBA) String() string {
+	return string(appendHueFunction(nil, "hwb(", hwba.H, hwba.W, hwba.B, hwba.A, cssDecimals))
+}
+
+// MarkPowerless returns the color with the hue marked as missing if it is powerless, because the color is gray.
+func (hwba HWBA) MarkPowerless() HWBA {
+	if SpaceHWB.powerless([3]float64{hwba.H, hwba.W, hwba.B}) {
+		hwba.H = math.NaN()
+	}
+
+	return hwba
+}
+
+// rgbToHWB converts red, green and blue ∈ [0, 1] to hue, whiteness and blackness.
+func rgbToHWB(red, green, blue float64) (float64, float64, float64) {
+	h, s, v := rgbToHSV(red, green, blue)
+
+	return h, (1.0 - s) * v, 1.0 - v
+}
+
+// hwbToRGB converts hue, whiteness and blackness to red, green and blue, all channels ∈ [0, 1].
+func hwbToRGB(h, w, b float64) (float64, float64, float64) {
+	// Whiteness and blackness that add up to more than 100% are normalized to a shade of gray.
+	if w+b >= 1.0 {
+		gray := w / (w + b)
+		return gray, gray, gray
+	}
+
+	red, green, blue := hslToRGB(h, 1.0, 0.5)
+	scale := 1.0 - w - b
+
+	return red*scale + w, green*scale + w, blue*scale + w
+}
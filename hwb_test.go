@@ -0,0 +1,94 @@
+package colorx
+
+import (
+	"image/color"
+	"testing"
+
+	"github.com/somebadcode/go-colorx/v2/internal/mathx"
+)
+
+func TestHWBAModel(t *testing.T) {
+	tests := []struct {
+		name string
+		c    color.Color
+		want HWBA
+	}{
+		{
+			name: "red",
+			c:    color.RGBA{R: 0xFF, A: 0xFF},
+			want: HWBA{A: 1.0},
+		},
+		{
+			name: "pink",
+			c:    color.NRGBA{R: 0xFF, G: 0x80, B: 0x80, A: 0x80},
+			want: HWBA{W: 0x80 / 255.0, A: 0x80 / 255.0},
+		},
+		{
+			name: "gray",
+			c:    color.Gray{Y: 0x80},
+			want: HWBA{W: 0x80 / 255.0, B: 1.0 - 0x80/255.0, A: 1.0},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := HWBAModel.Convert(tt.c).(HWBA)
+			if !mathx.EqualP(got.H, tt.want.H, 1e-4) || !mathx.EqualP(got.W, tt.want.W, 1e-4) ||
+				!mathx.EqualP(got.B, tt.want.B, 1e-4) || !mathx.EqualP(got.A, tt.want.A, 1e-4) {
+				t.Errorf("HWBAModel.Convert() = %+v, want %+v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestHWBA_RGBA(t *testing.T) {
+	tests := []struct {
+		name string
+		c    HWBA
+		want color.RGBA64
+	}{
+		{
+			name: "yellow",
+			c:    HWBA{H: 60.0, A: 1.0},
+			want: color.RGBA64{R: 0xFFFF, G: 0xFFFF, A: 0xFFFF},
+		},
+		{
+			name: "gray",
+			c:    HWBA{H: 200.0, W: 0.6, B: 0.6, A: 1.0},
+			want: color.RGBA64{R: 0x8000, G: 0x8000, B: 0x8000, A: 0xFFFF},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r, g, b, a := tt.c.RGBA()
+			if got := (color.RGBA64{R: uint16(r), G: uint16(g), B: uint16(b), A: uint16(a)}); got != tt.want {
+				t.Errorf("RGBA() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestHWBA_String(t *testing.T) {
+	tests := []struct {
+		name string
+		hwba HWBA
+		want string
+	}{
+		{
+			name: "opaque",
+			hwba: HWBA{H: 120.0, W: 0.2, B: 0.3, A: 1.0},
+			want: "hwb(120 20% 30%)",
+		},
+		{
+			name: "missing",
+			hwba: HWBA{H: 30.0, W: 0.5, B: 0.5, A: 0.5}.MarkPowerless(),
+			want: "hwb(none 50% 50% / 0.5)",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.hwba.String(); got != tt.want {
+				t.Errorf("String() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,14 @@
+package mathx
+
+import (
+	"math"
+)
+
+// Clamp limits x to the closed range [lo, hi]. NaN is clamped to lo.
+func Clamp(x, lo, hi float64) float64 {
+	if math.IsNaN(x) {
+		return lo
+	}
+
+	return math.Max(lo, math.Min(x, hi))
+}
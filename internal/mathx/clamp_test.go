@@ -0,0 +1,47 @@
+package mathx
+
+import (
+	"math"
+	"testing"
+)
+
+func TestClamp(t *testing.T) {
+	type args struct {
+		x  float64
+		lo float64
+		hi float64
+	}
+	tests := []struct {
+		name string
+		args args
+		want float64
+	}{
+		{
+			name: "inside",
+			args: args{x: 0.5, lo: 0.0, hi: 1.0},
+			want: 0.5,
+		},
+		{
+			name: "below",
+			args: args{x: -0.5, lo: 0.0, hi: 1.0},
+			want: 0.0,
+		},
+		{
+			name: "above",
+			args: args{x: 1.5, lo: 0.0, hi: 1.0},
+			want: 1.0,
+		},
+		{
+			name: "nan",
+			args: args{x: math.NaN(), lo: 0.0, hi: 1.0},
+			want: 0.0,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := Clamp(tt.args.x, tt.args.lo, tt.args.hi); got != tt.want {
+				t.Errorf("Clamp() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,66 @@
+package mathx
+
+// Matrix3 is a 3×3 matrix in row-major order.
+type Matrix3 [3][3]float64
+
+// Vector3 is a column vector of three elements.
+type Vector3 [3]float64
+
+// MulVec returns the product of m and v.
+func (m Matrix3) MulVec(v Vector3) Vector3 {
+	return Vector3{
+		m[0][0]*v[0] + m[0][1]*v[1] + m[0][2]*v[2],
+		m[1][0]*v[0] + m[1][1]*v[1] + m[1][2]*v[2],
+		m[2][0]*v[0] + m[2][1]*v[1] + m[2][2]*v[2],
+	}
+}
+
+// Mul returns the product of m and n.
+func (m Matrix3) Mul(n Matrix3) Matrix3 {
+	var p Matrix3
+
+	for i := 0; i < 3; i++ {
+		for j := 0; j < 3; j++ {
+			p[i][j] = m[i][0]*n[0][j] + m[i][1]*n[1][j] + m[i][2]*n[2][j]
+		}
+	}
+
+	return p
+}
+
+// Inverse returns the inverse of m. The result is undefined if m is singular.
+func (m Matrix3) Inverse() Matrix3 {
+	// Cofactors of the first row, reused for the determinant.
+	c00 := m[1][1]*m[2][2] - m[1][2]*m[2][1]
+	c01 := m[1][2]*m[2][0] - m[1][0]*m[2][2]
+	c02 := m[1][0]*m[2][1] - m[1][1]*m[2][0]
+
+	invDet := 1.0 / (m[0][0]*c00 + m[0][1]*c01 + m[0][2]*c02)
+
+	return Matrix3{
+		{
+			c00 * invDet,
+			(m[0][2]*m[2][1] - m[0][1]*m[2][2]) * invDet,
+			(m[0][1]*m[1][2] - m[0][2]*m[1][1]) * invDet,
+		},
+		{
+			c01 * invDet,
+			(m[0][0]*m[2][2] - m[0][2]*m[2][0]) * invDet,
+			(m[0][2]*m[1][0] - m[0][0]*m[1][2]) * invDet,
+		},
+		{
+			c02 * invDet,
+			(m[0][1]*m[2][0] - m[0][0]*m[2][1]) * invDet,
+			(m[0][0]*m[1][1] - m[0][1]*m[1][0]) * invDet,
+		},
+	}
+}
+
+// Diagonal returns the matrix with v on its diagonal and zero elsewhere.
+func Diagonal(v Vector3) Matrix3 {
+	return Matrix3{
+		{v[0], 0, 0},
+		{0, v[1], 0},
+		{0, 0, v[2]},
+	}
+}
@@ -0,0 +1,59 @@
+package mathx
+
+import (
+	"testing"
+)
+
+func TestMatrix3_MulVec(t *testing.T) {
+	m := Matrix3{
+		{1, 2, 3},
+		{4, 5, 6},
+		{7, 8, 9},
+	}
+
+	got := m.MulVec(Vector3{1, 0, -1})
+	want := Vector3{-2, -2, -2}
+	if got != want {
+		t.Errorf("MulVec() = %v, want %v", got, want)
+	}
+}
+
+func TestMatrix3_Inverse(t *testing.T) {
+	tests := []struct {
+		name string
+		m    Matrix3
+	}{
+		{
+			name: "identity",
+			m:    Diagonal(Vector3{1, 1, 1}),
+		},
+		{
+			name: "diagonal",
+			m:    Diagonal(Vector3{2, 4, 8}),
+		},
+		{
+			name: "bradford",
+			m: Matrix3{
+				{0.8951, 0.2664, -0.1614},
+				{-0.7502, 1.7135, 0.0367},
+				{0.0389, -0.0685, 1.0296},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := tt.m.Mul(tt.m.Inverse())
+			for i := 0; i < 3; i++ {
+				for j := 0; j < 3; j++ {
+					want := 0.0
+					if i == j {
+						want = 1.0
+					}
+					if !Equal(got[i][j], want) {
+						t.Errorf("m × Inverse()[%d][%d] = %v, want %v", i, j, got[i][j], want)
+					}
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,123 @@
+package colorx
+
+import (
+	"image/color"
+	"math"
+)
+
+const (
+	labEpsilon = 216.0 / 24389.0 // CIE ε, (6/29)³
+	labKappa   = 24389.0 / 27.0  // CIE κ, (29/3)³
+)
+
+// Lab is an implementation of the CIELAB (CIE L*a*b*) color model. Lab is designed to be perceptually uniform, so that
+// the distance between two colors is close to how different they look.
+type Lab struct {
+	L     float64    // Lightness ∈ [0, 100]
+	A     float64    // Green (negative) to red (positive), roughly ∈ [-125, 125]
+	B     float64    // Blue (negative) to yellow (positive), roughly ∈ [-125, 125]
+	Alpha float64    // Alpha ∈ [0, 1]
+	White WhitePoint // Reference white, D65 if zero
+}
+
+// LabModel can convert the color to the Lab color model defined in this package, relative to D65.
+var LabModel = color.ModelFunc(labModel)
+
+func labModel(c color.Color) color.Color {
+	return convertLab(c, D65)
+}
+
+// NewLabModel returns a model that can convert the color to the Lab color model defined in this package, relative to
+// the reference white.
+func NewLabModel(white WhitePoint) color.Model {
+	return color.ModelFunc(func(c color.Color) color.Color {
+		return convertLab(c, white)
+	})
+}
+
+func convertLab(c color.Color, white WhitePoint) Lab {
+	if lab, ok := c.(Lab); ok && lab.White.orD65() == white {
+		return lab
+	}
+
+	return labFromXYZ(convertXYZ(c, white))
+}
+
+// RGBAToLab converts RGBA to Lightness, a, b and Alpha relative to D65.
+func RGBAToLab(r, g, b, a uint8) (float64, float64, float64, float64) {
+	x, y, z, alpha := RGBAToXYZ(r, g, b, a)
+	lab := labFromXYZ(XYZ{X: x, Y: y, Z: z, A: alpha, White: D65})
+
+	return lab.L, lab.A, lab.B, lab.Alpha
+}
+
+// RGBA returns the alpha-premultiplied red, green, blue and alpha values for the color.
+func (lab Lab) RGBA() (r, g, b, a uint32) {
+	return premultiplyLinear(lab.linear())
+}
+
+func (lab Lab) linear() (r, g, b, a float64) {
+	return lab.xyz().linear()
+}
+
+// String returns the color in the CSS lab() function, such as "lab(50 20 -30 / 0.8)". The CSS function is relative to
+// D50, so a color relative to another reference white is converted first.
+func (lab Lab) String() string {
+	if lab.White != D50 {
+		lab = convertLab(lab, D50)
+	}
+
+	return functionString("lab(", lab.L, lab.A, lab.B, lab.Alpha)
+}
+
+// xyz converts the color to XYZ relative to the same reference white.
+func (lab Lab) xyz() XYZ {
+	white := lab.White.orD65()
+	l := orZero(lab.L)
+
+	fy := (l + 16.0) / 116.0
+	fx := fy + orZero(lab.A)/500.0
+	fz := fy - orZero(lab.B)/200.0
+
+	xr := fx * fx * fx
+	if xr <= labEpsilon {
+		xr = (116.0*fx - 16.0) / labKappa
+	}
+
+	yr := fy * fy * fy
+	if l <= labKappa*labEpsilon {
+		yr = l / labKappa
+	}
+
+	zr := fz * fz * fz
+	if zr <= labEpsilon {
+		zr = (116.0*fz - 16.0) / labKappa
+	}
+
+	return XYZ{X: xr * white.X, Y: yr * white.Y, Z: zr * white.Z, A: lab.Alpha, White: white}
+}
+
+// labFromXYZ converts XYZ to Lab relative to the same reference white.
+func labFromXYZ(xyz XYZ) Lab {
+	white := xyz.White.orD65()
+
+	fx := labF(xyz.X / white.X)
+	fy := labF(xyz.Y / white.Y)
+	fz := labF(xyz.Z / white.Z)
+
+	return Lab{
+		L:     116.0*fy - 16.0,
+		A:     500.0 * (fx - fy),
+		B:     200.0 * (fy - fz),
+		Alpha: xyz.A,
+		White: white,
+	}
+}
+
+func labF(t float64) float64 {
+	if t > labEpsilon {
+		return math.Cbrt(t)
+	}
+
+	return (labKappa*t + 16.0) / 116.0
+}
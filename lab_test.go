@@ -0,0 +1,175 @@
+package colorx
+
+import (
+	"image/color"
+	"math"
+	"testing"
+
+	"github.com/somebadcode/go-colorx/v2/internal/mathx"
+)
+
+func TestLabModel(t *testing.T) {
+	type args struct {
+		c color.Color
+	}
+	tests := []struct {
+		name  string
+		model color.Model
+		args  args
+		want  Lab
+	}{
+		{
+			name:  "white",
+			model: LabModel,
+			args:  args{c: color.White},
+			want:  Lab{L: 100.0, Alpha: 1.0},
+		},
+		{
+			name:  "red",
+			model: LabModel,
+			args:  args{c: color.RGBA{R: 0xFF, A: 0xFF}},
+			want:  Lab{L: 53.2371, A: 80.0901, B: 67.2033, Alpha: 1.0},
+		},
+		{
+			name:  "red_d50",
+			model: NewLabModel(D50),
+			args:  args{c: color.RGBA{R: 0xFF, A: 0xFF}},
+			want:  Lab{L: 54.2905, A: 80.8049, B: 69.8910, Alpha: 1.0},
+		},
+		{
+			name:  "gray_d50",
+			model: NewLabModel(D50),
+			args:  args{c: color.RGBA{R: 0x80, G: 0x80, B: 0x80, A: 0xFF}},
+			want:  Lab{L: 53.5850, Alpha: 1.0},
+		},
+		{
+			name:  "lab",
+			model: LabModel,
+			args:  args{c: Lab{L: 50.0, A: 10.0, B: -10.0, Alpha: 0.5}},
+			want:  Lab{L: 50.0, A: 10.0, B: -10.0, Alpha: 0.5},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, ok := tt.model.Convert(tt.args.c).(Lab)
+			if !ok {
+				t.Fatalf("LabModel.Convert() got = %T, want %T", got, tt.want)
+			}
+
+			if !mathx.EqualP(got.L, tt.want.L, 1e-3) {
+				t.Errorf("LabModel.Convert() got L = %f, want %f", got.L, tt.want.L)
+			}
+			if !mathx.EqualP(got.A, tt.want.A, 1e-3) {
+				t.Errorf("LabModel.Convert() got A = %f, want %f", got.A, tt.want.A)
+			}
+			if !mathx.EqualP(got.B, tt.want.B, 1e-3) {
+				t.Errorf("LabModel.Convert() got B = %f, want %f", got.B, tt.want.B)
+			}
+			if !mathx.EqualP(got.Alpha, tt.want.Alpha, 1e-3) {
+				t.Errorf("LabModel.Convert() got Alpha = %f, want %f", got.Alpha, tt.want.Alpha)
+			}
+		})
+	}
+}
+
+func TestLab_RGBA(t *testing.T) {
+	tests := []struct {
+		name  string
+		lab   Lab
+		wantR uint32
+		wantG uint32
+		wantB uint32
+		wantA uint32
+	}{
+		{
+			name:  "white",
+			lab:   Lab{L: 100.0, Alpha: 1.0},
+			wantR: 0xFFFF,
+			wantG: 0xFFFF,
+			wantB: 0xFFFF,
+			wantA: 0xFFFF,
+		},
+		{
+			name:  "red_d50",
+			lab:   Lab{L: 54.2905, A: 80.8049, B: 69.8910, Alpha: 1.0, White: D50},
+			wantR: 0xFFFF,
+			wantA: 0xFFFF,
+		},
+		{
+			name: "black_transparent",
+			lab:  Lab{L: 0.0},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			gotR, gotG, gotB, gotA := tt.lab.RGBA()
+			if !mathx.EqualP(float64(gotR), float64(tt.wantR), 2) {
+				t.Errorf("RGBA() gotR = %v, want %v", gotR, tt.wantR)
+			}
+			if !mathx.EqualP(float64(gotG), float64(tt.wantG), 2) {
+				t.Errorf("RGBA() gotG = %v, want %v", gotG, tt.wantG)
+			}
+			if !mathx.EqualP(float64(gotB), float64(tt.wantB), 2) {
+				t.Errorf("RGBA() gotB = %v, want %v", gotB, tt.wantB)
+			}
+			if gotA != tt.wantA {
+				t.Errorf("RGBA() gotA = %v, want %v", gotA, tt.wantA)
+			}
+		})
+	}
+}
+
+func TestLab_String(t *testing.T) {
+	tests := []struct {
+		name string
+		lab  Lab
+		want string
+	}{
+		{
+			name: "d50",
+			lab:  Lab{L: 50, A: 20.5, B: -30, Alpha: 0.5, White: D50},
+			want: "lab(50 20.5 -30 / 0.5)",
+		},
+		{
+			name: "d65_white",
+			lab:  Lab{L: 100, Alpha: 1},
+			want: "lab(100 0 0)",
+		},
+		{
+			name: "missing",
+			lab:  Lab{L: math.NaN(), A: 1, B: 2, Alpha: 1, White: D50},
+			want: "lab(none 1 2)",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.lab.String(); got != tt.want {
+				t.Errorf("String() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestRGBAToLab(t *testing.T) {
+	gotL, gotA, gotB, gotAlpha := RGBAToLab(0x00, 0x00, 0xFF, 0x80)
+	if !mathx.EqualP(gotL, 32.30, 1e-2) {
+		t.Errorf("RGBAToLab() got L = %f, want %f", gotL, 32.30)
+	}
+	if !mathx.EqualP(gotA, 79.19, 1e-2) {
+		t.Errorf("RGBAToLab() got A = %f, want %f", gotA, 79.19)
+	}
+	if !mathx.EqualP(gotB, -107.86, 1e-2) {
+		t.Errorf("RGBAToLab() got B = %f, want %f", gotB, -107.86)
+	}
+	if !mathx.EqualP(gotAlpha, 0.5, 1e-2) {
+		t.Errorf("RGBAToLab() got Alpha = %f, want %f", gotAlpha, 0.5)
+	}
+}
+
+func BenchmarkLab_RGBA(b *testing.B) {
+	lab := Lab{L: 50.0, A: 20.0, B: -20.0, Alpha: 1.0}
+
+	for n := 0; n < b.N; n++ {
+		lab.RGBA()
+	}
+}
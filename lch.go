@@ -0,0 +1,111 @@
+package colorx
+
+import (
+	"image/color"
+	"math"
+
+	"github.com/somebadcode/go-colorx/v2/internal/mathx"
+)
+
+// LCh is an implementation of the CIE LCh(ab) color model, the cylindrical form of Lab. Changing the hue of an LCh
+// color keeps its lightness and chroma, unlike with HSLA and HSVA. A component can be NaN to mark it as missing, like
+// the CSS keyword "none", and converts as zero.
+type LCh struct {
+	L     float64    // Lightness ∈ [0, 100]
+	C     float64    // Chroma ≥ 0, roughly ≤ 150 for colors in the sRGB gamut
+	H     float64    // Hue ∈ [0, 360)
+	Alpha float64    // Alpha ∈ [0, 1]
+	White WhitePoint // Reference white, D65 if zero
+}
+
+// LChModel can convert the color to the LCh color model defined in this package, relative to D65.
+var LChModel = color.ModelFunc(lchModel)
+
+func lchModel(c color.Color) color.Color {
+	return convertLCh(c, D65)
+}
+
+// NewLChModel returns a model that can convert the color to the LCh color model defined in this package, relative to
+// the reference white.
+func NewLChModel(white WhitePoint) color.Model {
+	return color.ModelFunc(func(c color.Color) color.Color {
+		return convertLCh(c, white)
+	})
+}
+
+func convertLCh(c color.Color, white WhitePoint) LCh {
+	if lch, ok := c.(LCh); ok && lch.White.orD65() == white {
+		return lch
+	}
+
+	return lchFromLab(convertLab(c, white))
+}
+
+// RGBAToLCh converts RGBA to Lightness, Chroma, Hue and Alpha relative to D65.
+func RGBAToLCh(r, g, b, a uint8) (float64, float64, float64, float64) {
+	l, labA, labB, alpha := RGBAToLab(r, g, b, a)
+	c, h := toPolar(labA, labB)
+
+	return l, c, h, alpha
+}
+
+// RGBA returns the alpha-premultiplied red, green, blue and alpha values for the color.
+func (lch LCh) RGBA() (r, g, b, a uint32) {
+	return premultiplyLinear(lch.linear())
+}
+
+func (lch LCh) linear() (r, g, b, a float64) {
+	return lch.lab().linear()
+}
+
+// MarkPowerless returns the color with the hue marked as missing if it is powerless, because the chroma is about zero.
+func (lch LCh) MarkPowerless() LCh {
+	if lch.C < lchAchromatic {
+		lch.H = math.NaN()
+	}
+
+	return lch
+}
+
+// String returns the color in the CSS lch() function, such as "lch(50 40 120 / 0.8)". The CSS function is relative to
+// D50, so a color relative to another reference white is converted first.
+func (lch LCh) String() string {
+	if lch.White != D50 {
+		lch = convertLCh(lch, D50)
+	}
+
+	return functionString("lch(", lch.L, lch.C, lch.H, lch.Alpha)
+}
+
+// lab converts the color to Lab relative to the same reference white.
+func (lch LCh) lab() Lab {
+	a, b := fromPolar(orZero(lch.C), orZero(lch.H))
+
+	return Lab{L: lch.L, A: a, B: b, Alpha: lch.Alpha, White: lch.White.orD65()}
+}
+
+func lchFromLab(lab Lab) LCh {
+	c, h := toPolar(lab.A, lab.B)
+
+	return LCh{L: lab.L, C: c, H: h, Alpha: lab.Alpha, White: lab.White.orD65()}
+}
+
+// toPolar converts the rectangular coordinates of an opponent color model to chroma and hue in degrees ∈ [0, 360).
+// The hue of an achromatic color is zero.
+func toPolar(a, b float64) (float64, float64) {
+	c := math.Hypot(a, b)
+	if mathx.Equal(c, 0.0) {
+		return c, 0.0
+	}
+
+	h := math.Atan2(b, a) * 180.0 / math.Pi
+
+	return c, math.Mod(h+360.0, 360.0)
+}
+
+// fromPolar converts chroma and hue in degrees to the rectangular coordinates of an opponent color model.
+func fromPolar(c, h float64) (float64, float64) {
+	sin, cos := math.Sincos(h * math.Pi / 180.0)
+
+	return c * cos, c * sin
+}
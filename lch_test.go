@@ -0,0 +1,91 @@
+package colorx
+
+import (
+	"image/color"
+	"testing"
+
+	"github.com/somebadcode/go-colorx/v2/internal/mathx"
+)
+
+func TestLChModel(t *testing.T) {
+	type args struct {
+		c color.Color
+	}
+	tests := []struct {
+		name  string
+		model color.Model
+		args  args
+		want  LCh
+	}{
+		{
+			name:  "gray",
+			model: LChModel,
+			args:  args{c: color.Gray{Y: 0x80}},
+			want:  LCh{L: 53.5850, Alpha: 1.0},
+		},
+		{
+			name:  "red",
+			model: LChModel,
+			args:  args{c: color.RGBA{R: 0xFF, A: 0xFF}},
+			want:  LCh{L: 53.2371, C: 104.5500, H: 39.9999, Alpha: 1.0},
+		},
+		{
+			name:  "rebeccapurple_d50",
+			model: NewLChModel(D50),
+			args:  args{c: CSS{R: 0x66, G: 0x33, B: 0x99, Opacity: 1.0}},
+			want:  LCh{L: 32.3927, C: 61.2435, H: 308.8577, Alpha: 1.0},
+		},
+		{
+			name:  "lch",
+			model: LChModel,
+			args:  args{c: LCh{L: 50.0, C: 10.0, H: 270.0, Alpha: 0.5}},
+			want:  LCh{L: 50.0, C: 10.0, H: 270.0, Alpha: 0.5},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, ok := tt.model.Convert(tt.args.c).(LCh)
+			if !ok {
+				t.Fatalf("LChModel.Convert() got = %T, want %T", got, tt.want)
+			}
+
+			if !mathx.EqualP(got.L, tt.want.L, 1e-3) {
+				t.Errorf("LChModel.Convert() got L = %f, want %f", got.L, tt.want.L)
+			}
+			if !mathx.EqualP(got.C, tt.want.C, 1e-3) {
+				t.Errorf("LChModel.Convert() got C = %f, want %f", got.C, tt.want.C)
+			}
+			if !mathx.EqualP(got.H, tt.want.H, 1e-3) {
+				t.Errorf("LChModel.Convert() got H = %f, want %f", got.H, tt.want.H)
+			}
+			if !mathx.EqualP(got.Alpha, tt.want.Alpha, 1e-3) {
+				t.Errorf("LChModel.Convert() got Alpha = %f, want %f", got.Alpha, tt.want.Alpha)
+			}
+		})
+	}
+}
+
+func TestLCh_RGBA(t *testing.T) {
+	lch := LCh{L: 53.2371, C: 104.5500, H: 39.9999, Alpha: 1.0}
+
+	gotR, gotG, gotB, gotA := lch.RGBA()
+	if gotR != 0xFFFF || gotG > 1 || gotB > 1 || gotA != 0xFFFF {
+		t.Errorf("RGBA() = %v, %v, %v, %v, want red", gotR, gotG, gotB, gotA)
+	}
+}
+
+func TestRGBAToLCh(t *testing.T) {
+	gotL, gotC, gotH, gotA := RGBAToLCh(0x00, 0xFF, 0x00, 0xFF)
+	if !mathx.EqualP(gotL, 87.7350, 1e-3) {
+		t.Errorf("RGBAToLCh() got L = %f, want %f", gotL, 87.7350)
+	}
+	if !mathx.EqualP(gotC, 119.78, 1e-2) {
+		t.Errorf("RGBAToLCh() got C = %f, want %f", gotC, 119.78)
+	}
+	if !mathx.EqualP(gotH, 136.01, 1e-2) {
+		t.Errorf("RGBAToLCh() got H = %f, want %f", gotH, 136.01)
+	}
+	if gotA != 1.0 {
+		t.Errorf("RGBAToLCh() got A = %f, want %f", gotA, 1.0)
+	}
+}
@@ -0,0 +1,97 @@
+package colorx
+
+import (
+	"image/color"
+	"math"
+
+	"github.com/somebadcode/go-colorx/v2/internal/mathx"
+)
+
+// MixOption configures how Mix interpolates colors.
+type MixOption func(*mixOptions)
+
+type mixOptions struct {
+	space Space
+	hue   HueInterpolation
+}
+
+// InSpace makes Mix interpolate in the color space. The default is SpaceOKLab, like in CSS.
+func InSpace(space Space) MixOption {
+	return func(o *mixOptions) {
+		o.space = space
+	}
+}
+
+// WithHueInterpolation makes Mix interpolate hues with the method. The default is HueShorter. It only affects the
+// spaces with a hue, SpaceHSL, SpaceHSV, SpaceHWB, SpaceLCh and SpaceOKLCh.
+func WithHueInterpolation(method HueInterpolation) MixOption {
+	return func(o *mixOptions) {
+		o.hue = method
+	}
+}
+
+// Mix interpolates between the colors a and b, where t = 0 returns a and t = 1 returns b. t is clamped to [0, 1]. The
+// result has the type of the interpolation space, see Space.
+//
+// Mix follows the CSS color-mix() function: the colors are converted to the space, a powerless hue of an achromatic
+// color and a missing component take the value of the other color, and the components are interpolated with
+// premultiplied alpha, so that a transparent color does not darken the mix.
+func Mix(a, b color.Color, t float64, opts ...MixOption) color.Color {
+	o := mixOptions{space: SpaceOKLab, hue: HueShorter}
+	for _, opt := range opts {
+		opt(&o)
+	}
+
+	t = mathx.Clamp(t, 0, 1)
+
+	v1, alpha1 := toSpace(a, o.space)
+	v2, alpha2 := toSpace(b, o.space)
+
+	hue := o.space.hueIndex()
+
+	// A missing component takes the value of the other color. Missing hues are handled by fixupHues.
+	for i := range v1 {
+		if i != hue {
+			v1[i], v2[i] = fillMissing(v1[i], v2[i])
+		}
+	}
+	alpha1, alpha2 = fillMissing(alpha1, alpha2)
+
+	if hue >= 0 {
+		v1[hue], v2[hue] = fixupHues(v1[hue], v2[hue], o.hue)
+	}
+
+	alpha := lerp(alpha1, alpha2, t)
+
+	var v [3]float64
+	for i := range v {
+		if i == hue {
+			v[i] = normalizeHue(lerp(v1[i], v2[i], t))
+			continue
+		}
+
+		v[i] = lerp(v1[i]*alpha1, v2[i]*alpha2, t)
+		if alpha != 0 {
+			v[i] /= alpha
+		}
+	}
+
+	return fromSpace(o.space, v, alpha)
+}
+
+// fillMissing returns the components of two colors with a missing (NaN) component replaced by the other one.
+func fillMissing(a, b float64) (float64, float64) {
+	if math.IsNaN(a) {
+		return b, b
+	}
+
+	if math.IsNaN(b) {
+		return a, a
+	}
+
+	return a, b
+}
+
+func lerp(a, b, t float64) float64 {
+	return a + (b-a)*t
+}
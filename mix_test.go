@@ -0,0 +1,233 @@
+package colorx
+
+import (
+	"fmt"
+	"image/color"
+	"math"
+	"testing"
+
+	"github.com/somebadcode/go-colorx/v2/internal/mathx"
+)
+
+func TestMix(t *testing.T) {
+	red := CSS{R: 0xFF, Opacity: 1.0}
+	blue := CSS{B: 0xFF, Opacity: 1.0}
+
+	type args struct {
+		a    color.Color
+		b    color.Color
+		t    float64
+		opts []MixOption
+	}
+	tests := []struct {
+		name string
+		args args
+		want color.Color
+	}{
+		{
+			name: "srgb",
+			args: args{a: red, b: blue, t: 0.5, opts: []MixOption{InSpace(SpaceSRGB)}},
+			want: SRGB{R: 0.5, B: 0.5, A: 1.0},
+		},
+		{
+			name: "srgb_start",
+			args: args{a: red, b: blue, t: -1.0, opts: []MixOption{InSpace(SpaceSRGB)}},
+			want: SRGB{R: 1.0, A: 1.0},
+		},
+		{
+			name: "srgb_end",
+			args: args{a: red, b: blue, t: 2.0, opts: []MixOption{InSpace(SpaceSRGB)}},
+			want: SRGB{B: 1.0, A: 1.0},
+		},
+		{
+			name: "linear_srgb",
+			args: args{a: color.Black, b: color.White, t: 0.5, opts: []MixOption{InSpace(SpaceLinearSRGB)}},
+			want: LinearSRGB{R: 0.5, G: 0.5, B: 0.5, A: 1.0},
+		},
+		{
+			name: "premultiplied_transparent",
+			args: args{a: color.Transparent, b: red, t: 0.5, opts: []MixOption{InSpace(SpaceSRGB)}},
+			want: SRGB{R: 1.0, A: 0.5},
+		},
+		{
+			name: "premultiplied_translucent",
+			args: args{a: CSS{R: 0xFF, Opacity: 0.5}, b: blue, t: 0.5, opts: []MixOption{InSpace(SpaceSRGB)}},
+			want: SRGB{R: 1.0 / 3.0, B: 2.0 / 3.0, A: 0.75},
+		},
+		{
+			name: "hsl_shorter",
+			args: args{a: red, b: blue, t: 0.5, opts: []MixOption{InSpace(SpaceHSL)}},
+			want: HSLA{H: 300.0, S: 1.0, L: 0.5, A: 1.0},
+		},
+		{
+			name: "hsl_longer",
+			args: args{a: red, b: blue, t: 0.5, opts: []MixOption{InSpace(SpaceHSL), WithHueInterpolation(HueLonger)}},
+			want: HSLA{H: 120.0, S: 1.0, L: 0.5, A: 1.0},
+		},
+		{
+			name: "hsl_increasing",
+			args: args{a: blue, b: red, t: 0.25, opts: []MixOption{InSpace(SpaceHSL), WithHueInterpolation(HueIncreasing)}},
+			want: HSLA{H: 270.0, S: 1.0, L: 0.5, A: 1.0},
+		},
+		{
+			name: "hsl_decreasing",
+			args: args{a: red, b: blue, t: 0.5, opts: []MixOption{InSpace(SpaceHSL), WithHueInterpolation(HueDecreasing)}},
+			want: HSLA{H: 300.0, S: 1.0, L: 0.5, A: 1.0},
+		},
+		{
+			name: "hsl_wrap_around",
+			args: args{
+				a:    HSLA{H: 350.0, S: 1.0, L: 0.5, A: 1.0},
+				b:    HSLA{H: 30.0, S: 1.0, L: 0.5, A: 1.0},
+				t:    0.5,
+				opts: []MixOption{InSpace(SpaceHSL)},
+			},
+			want: HSLA{H: 10.0, S: 1.0, L: 0.5, A: 1.0},
+		},
+		{
+			name: "hsl_powerless_hue",
+			args: args{a: color.White, b: blue, t: 0.5, opts: []MixOption{InSpace(SpaceHSL)}},
+			want: HSLA{H: 240.0, S: 0.5, L: 0.75, A: 1.0},
+		},
+		{
+			name: "hwb",
+			args: args{a: red, b: CSS{G: 0xFF, Opacity: 1.0}, t: 0.5, opts: []MixOption{InSpace(SpaceHWB)}},
+			want: HWBA{H: 60.0, A: 1.0},
+		},
+		{
+			name: "lab",
+			args: args{a: color.Black, b: color.White, t: 0.25, opts: []MixOption{InSpace(SpaceLab)}},
+			want: Lab{L: 25.0, Alpha: 1.0, White: D50},
+		},
+		{
+			name: "lch_powerless_hue",
+			args: args{a: color.Black, b: LCh{L: 50.0, C: 40.0, H: 120.0, Alpha: 1.0, White: D50}, t: 0.5,
+				opts: []MixOption{InSpace(SpaceLCh)}},
+			want: LCh{L: 25.0, C: 20.0, H: 120.0, Alpha: 1.0, White: D50},
+		},
+		{
+			name: "oklab_default",
+			args: args{a: color.Black, b: color.White, t: 0.5},
+			want: OKLab{L: 0.5, Alpha: 1.0},
+		},
+		{
+			name: "oklch",
+			args: args{
+				a:    OKLCh{L: 0.6, C: 0.1, H: 340.0, Alpha: 1.0},
+				b:    OKLCh{L: 0.8, C: 0.2, H: 20.0, Alpha: 0.5},
+				t:    0.5,
+				opts: []MixOption{InSpace(SpaceOKLCh)},
+			},
+			want: OKLCh{L: (0.6 + 0.8*0.5) / 1.5, C: (0.1 + 0.2*0.5) / 1.5, Alpha: 0.75},
+		},
+		{
+			name: "missing_component",
+			args: args{a: OKLab{L: math.NaN(), A: 0.1, Alpha: 1.0}, b: OKLab{L: 0.6, B: 0.1, Alpha: 1.0}, t: 0.5},
+			want: OKLab{L: 0.6, A: 0.05, B: 0.05, Alpha: 1.0},
+		},
+		{
+			name: "hsl_missing_hue",
+			args: args{a: HSLA{H: math.NaN(), S: 0.5, L: 0.5, A: 1.0}, b: HSLA{H: 120.0, S: 0.5, L: 0.25, A: 1.0},
+				t: 0.5, opts: []MixOption{InSpace(SpaceHSL)}},
+			want: HSLA{H: 120.0, S: 0.5, L: 0.375, A: 1.0},
+		},
+		{
+			name: "hsv_gray",
+			args: args{a: HSVA{S: 0, V: 1.0, A: 1.0}, b: HSVA{H: 240.0, S: 1.0, V: 1.0, A: 1.0}, t: 0.5,
+				opts: []MixOption{InSpace(SpaceHSV)}},
+			want: HSVA{H: 240.0, S: 0.5, V: 1.0, A: 1.0},
+		},
+		{
+			name: "oklch_missing_both",
+			args: args{a: OKLCh{L: 0.2, H: math.NaN(), Alpha: 1.0}, b: OKLCh{L: 0.8, H: math.NaN(), Alpha: 1.0},
+				t: 0.5, opts: []MixOption{InSpace(SpaceOKLCh)}},
+			want: OKLCh{L: 0.5, H: math.NaN(), Alpha: 1.0},
+		},
+		{
+			name: "hsl_grays",
+			args: args{a: color.Black, b: color.White, t: 0.5, opts: []MixOption{InSpace(SpaceHSL)}},
+			want: HSLA{H: math.NaN(), L: 0.5, A: 1.0},
+		},
+		{
+			name: "lch_grays",
+			args: args{a: color.Black, b: color.White, t: 0.5, opts: []MixOption{InSpace(SpaceLCh)}},
+			want: LCh{L: 50.0, H: math.NaN(), Alpha: 1.0, White: D50},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := Mix(tt.args.a, tt.args.b, tt.args.t, tt.args.opts...)
+			if fmt.Sprintf("%T", got) != fmt.Sprintf("%T", tt.want) {
+				t.Fatalf("Mix() = %T, want %T", got, tt.want)
+			}
+
+			gotV, wantV := mixComponents(got), mixComponents(tt.want)
+			for i := range gotV {
+				if math.IsNaN(gotV[i]) != math.IsNaN(wantV[i]) ||
+					!math.IsNaN(wantV[i]) && !mathx.EqualP(gotV[i], wantV[i], 1e-3) {
+					t.Errorf("Mix() = %+v, want %+v", got, tt.want)
+					break
+				}
+			}
+		})
+	}
+}
+
+func mixComponents(c color.Color) []float64 {
+	switch c := c.(type) {
+	case SRGB:
+		return []float64{c.R, c.G, c.B, c.A}
+	case LinearSRGB:
+		return []float64{c.R, c.G, c.B, c.A}
+	case HSLA:
+		return []float64{c.H, c.S, c.L, c.A}
+	case HSVA:
+		return []float64{c.H, c.S, c.V, c.A}
+	case HWBA:
+		return []float64{c.H, c.W, c.B, c.A}
+	case Lab:
+		return []float64{c.L, c.A, c.B, c.Alpha, c.White.X, c.White.Z}
+	case LCh:
+		return []float64{c.L, c.C, c.H, c.Alpha, c.White.X, c.White.Z}
+	case OKLab:
+		return []float64{c.L, c.A, c.B, c.Alpha}
+	case OKLCh:
+		return []float64{c.L, c.C, c.H, c.Alpha}
+	default:
+		return []float64{math.NaN()}
+	}
+}
+
+func TestSpace_String(t *testing.T) {
+	tests := []struct {
+		space Space
+		want  string
+	}{
+		{space: SpaceSRGB, want: "srgb"},
+		{space: SpaceLinearSRGB, want: "srgb-linear"},
+		{space: SpaceHSL, want: "hsl"},
+		{space: SpaceHWB, want: "hwb"},
+		{space: SpaceLab, want: "lab"},
+		{space: SpaceLCh, want: "lch"},
+		{space: SpaceOKLab, want: "oklab"},
+		{space: SpaceOKLCh, want: "oklch"},
+		{space: SpaceHSV, want: "hsv"},
+		{space: Space(42), want: "Space(42)"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.want, func(t *testing.T) {
+			if got := tt.space.String(); got != tt.want {
+				t.Errorf("String() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func BenchmarkMix(b *testing.B) {
+	c1 := CSS{R: 0xFF, G: 0x80, Opacity: 1.0}
+	c2 := CSS{B: 0xFF, G: 0x40, Opacity: 0.5}
+
+	for n := 0; n < b.N; n++ {
+		Mix(c1, c2, 0.3, InSpace(SpaceOKLCh))
+	}
+}
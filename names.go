@@ -0,0 +1,212 @@
+package colorx
+
+import (
+	"math"
+	"sort"
+	"strings"
+)
+
+// cssNames maps the lowercase named colors of CSS Color Module Level 4, and "transparent", to their colors.
+var cssNames = map[string]CSS{
+	"transparent":          {},
+	"aliceblue":            {R: 0xF0, G: 0xF8, B: 0xFF, Opacity: 1.0},
+	"antiquewhite":         {R: 0xFA, G: 0xEB, B: 0xD7, Opacity: 1.0},
+	"aqua":                 {R: 0x00, G: 0xFF, B: 0xFF, Opacity: 1.0},
+	"aquamarine":           {R: 0x7F, G: 0xFF, B: 0xD4, Opacity: 1.0},
+	"azure":                {R: 0xF0, G: 0xFF, B: 0xFF, Opacity: 1.0},
+	"beige":                {R: 0xF5, G: 0xF5, B: 0xDC, Opacity: 1.0},
+	"bisque":               {R: 0xFF, G: 0xE4, B: 0xC4, Opacity: 1.0},
+	"black":                {R: 0x00, G: 0x00, B: 0x00, Opacity: 1.0},
+	"blanchedalmond":       {R: 0xFF, G: 0xEB, B: 0xCD, Opacity: 1.0},
+	"blue":                 {R: 0x00, G: 0x00, B: 0xFF, Opacity: 1.0},
+	"blueviolet":           {R: 0x8A, G: 0x2B, B: 0xE2, Opacity: 1.0},
+	"brown":                {R: 0xA5, G: 0x2A, B: 0x2A, Opacity: 1.0},
+	"burlywood":            {R: 0xDE, G: 0xB8, B: 0x87, Opacity: 1.0},
+	"cadetblue":            {R: 0x5F, G: 0x9E, B: 0xA0, Opacity: 1.0},
+	"chartreuse":           {R: 0x7F, G: 0xFF, B: 0x00, Opacity: 1.0},
+	"chocolate":            {R: 0xD2, G: 0x69, B: 0x1E, Opacity: 1.0},
+	"coral":                {R: 0xFF, G: 0x7F, B: 0x50, Opacity: 1.0},
+	"cornflowerblue":       {R: 0x64, G: 0x95, B: 0xED, Opacity: 1.0},
+	"cornsilk":             {R: 0xFF, G: 0xF8, B: 0xDC, Opacity: 1.0},
+	"crimson":              {R: 0xDC, G: 0x14, B: 0x3C, Opacity: 1.0},
+	"cyan":                 {R: 0x00, G: 0xFF, B: 0xFF, Opacity: 1.0},
+	"darkblue":             {R: 0x00, G: 0x00, B: 0x8B, Opacity: 1.0},
+	"darkcyan":             {R: 0x00, G: 0x8B, B: 0x8B, Opacity: 1.0},
+	"darkgoldenrod":        {R: 0xB8, G: 0x86, B: 0x0B, Opacity: 1.0},
+	"darkgray":             {R: 0xA9, G: 0xA9, B: 0xA9, Opacity: 1.0},
+	"darkgreen":            {R: 0x00, G: 0x64, B: 0x00, Opacity: 1.0},
+	"darkgrey":             {R: 0xA9, G: 0xA9, B: 0xA9, Opacity: 1.0},
+	"darkkhaki":            {R: 0xBD, G: 0xB7, B: 0x6B, Opacity: 1.0},
+	"darkmagenta":          {R: 0x8B, G: 0x00, B: 0x8B, Opacity: 1.0},
+	"darkolivegreen":       {R: 0x55, G: 0x6B, B: 0x2F, Opacity: 1.0},
+	"darkorange":           {R: 0xFF, G: 0x8C, B: 0x00, Opacity: 1.0},
+	"darkorchid":           {R: 0x99, G: 0x32, B: 0xCC, Opacity: 1.0},
+	"darkred":              {R: 0x8B, G: 0x00, B: 0x00, Opacity: 1.0},
+	"darksalmon":           {R: 0xE9, G: 0x96, B: 0x7A, Opacity: 1.0},
+	"darkseagreen":         {R: 0x8F, G: 0xBC, B: 0x8F, Opacity: 1.0},
+	"darkslateblue":        {R: 0x48, G: 0x3D, B: 0x8B, Opacity: 1.0},
+	"darkslategray":        {R: 0x2F, G: 0x4F, B: 0x4F, Opacity: 1.0},
+	"darkslategrey":        {R: 0x2F, G: 0x4F, B: 0x4F, Opacity: 1.0},
+	"darkturquoise":        {R: 0x00, G: 0xCE, B: 0xD1, Opacity: 1.0},
+	"darkviolet":           {R: 0x94, G: 0x00, B: 0xD3, Opacity: 1.0},
+	"deeppink":             {R: 0xFF, G: 0x14, B: 0x93, Opacity: 1.0},
+	"deepskyblue":          {R: 0x00, G: 0xBF, B: 0xFF, Opacity: 1.0},
+	"dimgray":              {R: 0x69, G: 0x69, B: 0x69, Opacity: 1.0},
+	"dimgrey":              {R: 0x69, G: 0x69, B: 0x69, Opacity: 1.0},
+	"dodgerblue":           {R: 0x1E, G: 0x90, B: 0xFF, Opacity: 1.0},
+	"firebrick":            {R: 0xB2, G: 0x22, B: 0x22, Opacity: 1.0},
+	"floralwhite":          {R: 0xFF, G: 0xFA, B: 0xF0, Opacity: 1.0},
+	"forestgreen":          {R: 0x22, G: 0x8B, B: 0x22, Opacity: 1.0},
+	"fuchsia":              {R: 0xFF, G: 0x00, B: 0xFF, Opacity: 1.0},
+	"gainsboro":            {R: 0xDC, G: 0xDC, B: 0xDC, Opacity: 1.0},
+	"ghostwhite":           {R: 0xF8, G: 0xF8, B: 0xFF, Opacity: 1.0},
+	"gold":                 {R: 0xFF, G: 0xD7, B: 0x00, Opacity: 1.0},
+	"goldenrod":            {R: 0xDA, G: 0xA5, B: 0x20, Opacity: 1.0},
+	"gray":                 {R: 0x80, G: 0x80, B: 0x80, Opacity: 1.0},
+	"green":                {R: 0x00, G: 0x80, B: 0x00, Opacity: 1.0},
+	"greenyellow":          {R: 0xAD, G: 0xFF, B: 0x2F, Opacity: 1.0},
+	"grey":                 {R: 0x80, G: 0x80, B: 0x80, Opacity: 1.0},
+	"honeydew":             {R: 0xF0, G: 0xFF, B: 0xF0, Opacity: 1.0},
+	"hotpink":              {R: 0xFF, G: 0x69, B: 0xB4, Opacity: 1.0},
+	"indianred":            {R: 0xCD, G: 0x5C, B: 0x5C, Opacity: 1.0},
+	"indigo":               {R: 0x4B, G: 0x00, B: 0x82, Opacity: 1.0},
+	"ivory":                {R: 0xFF, G: 0xFF, B: 0xF0, Opacity: 1.0},
+	"khaki":                {R: 0xF0, G: 0xE6, B: 0x8C, Opacity: 1.0},
+	"lavender":             {R: 0xE6, G: 0xE6, B: 0xFA, Opacity: 1.0},
+	"lavenderblush":        {R: 0xFF, G: 0xF0, B: 0xF5, Opacity: 1.0},
+	"lawngreen":            {R: 0x7C, G: 0xFC, B: 0x00, Opacity: 1.0},
+	"lemonchiffon":         {R: 0xFF, G: 0xFA, B: 0xCD, Opacity: 1.0},
+	"lightblue":            {R: 0xAD, G: 0xD8, B: 0xE6, Opacity: 1.0},
+	"lightcoral":           {R: 0xF0, G: 0x80, B: 0x80, Opacity: 1.0},
+	"lightcyan":            {R: 0xE0, G: 0xFF, B: 0xFF, Opacity: 1.0},
+	"lightgoldenrodyellow": {R: 0xFA, G: 0xFA, B: 0xD2, Opacity: 1.0},
+	"lightgray":            {R: 0xD3, G: 0xD3, B: 0xD3, Opacity: 1.0},
+	"lightgreen":           {R: 0x90, G: 0xEE, B: 0x90, Opacity: 1.0},
+	"lightgrey":            {R: 0xD3, G: 0xD3, B: 0xD3, Opacity: 1.0},
+	"lightpink":            {R: 0xFF, G: 0xB6, B: 0xC1, Opacity: 1.0},
+	"lightsalmon":          {R: 0xFF, G: 0xA0, B: 0x7A, Opacity: 1.0},
+	"lightseagreen":        {R: 0x20, G: 0xB2, B: 0xAA, Opacity: 1.0},
+	"lightskyblue":         {R: 0x87, G: 0xCE, B: 0xFA, Opacity: 1.0},
+	"lightslategray":       {R: 0x77, G: 0x88, B: 0x99, Opacity: 1.0},
+	"lightslategrey":       {R: 0x77, G: 0x88, B: 0x99, Opacity: 1.0},
+	"lightsteelblue":       {R: 0xB0, G: 0xC4, B: 0xDE, Opacity: 1.0},
+	"lightyellow":          {R: 0xFF, G: 0xFF, B: 0xE0, Opacity: 1.0},
+	"lime":                 {R: 0x00, G: 0xFF, B: 0x00, Opacity: 1.0},
+	"limegreen":            {R: 0x32, G: 0xCD, B: 0x32, Opacity: 1.0},
+	"linen":                {R: 0xFA, G: 0xF0, B: 0xE6, Opacity: 1.0},
+	"magenta":              {R: 0xFF, G: 0x00, B: 0xFF, Opacity: 1.0},
+	"maroon":               {R: 0x80, G: 0x00, B: 0x00, Opacity: 1.0},
+	"mediumaquamarine":     {R: 0x66, G: 0xCD, B: 0xAA, Opacity: 1.0},
+	"mediumblue":           {R: 0x00, G: 0x00, B: 0xCD, Opacity: 1.0},
+	"mediumorchid":         {R: 0xBA, G: 0x55, B: 0xD3, Opacity: 1.0},
+	"mediumpurple":         {R: 0x93, G: 0x70, B: 0xDB, Opacity: 1.0},
+	"mediumseagreen":       {R: 0x3C, G: 0xB3, B: 0x71, Opacity: 1.0},
+	"mediumslateblue":      {R: 0x7B, G: 0x68, B: 0xEE, Opacity: 1.0},
+	"mediumspringgreen":    {R: 0x00, G: 0xFA, B: 0x9A, Opacity: 1.0},
+	"mediumturquoise":      {R: 0x48, G: 0xD1, B: 0xCC, Opacity: 1.0},
+	"mediumvioletred":      {R: 0xC7, G: 0x15, B: 0x85, Opacity: 1.0},
+	"midnightblue":         {R: 0x19, G: 0x19, B: 0x70, Opacity: 1.0},
+	"mintcream":            {R: 0xF5, G: 0xFF, B: 0xFA, Opacity: 1.0},
+	"mistyrose":            {R: 0xFF, G: 0xE4, B: 0xE1, Opacity: 1.0},
+	"moccasin":             {R: 0xFF, G: 0xE4, B: 0xB5, Opacity: 1.0},
+	"navajowhite":          {R: 0xFF, G: 0xDE, B: 0xAD, Opacity: 1.0},
+	"navy":                 {R: 0x00, G: 0x00, B: 0x80, Opacity: 1.0},
+	"oldlace":              {R: 0xFD, G: 0xF5, B: 0xE6, Opacity: 1.0},
+	"olive":                {R: 0x80, G: 0x80, B: 0x00, Opacity: 1.0},
+	"olivedrab":            {R: 0x6B, G: 0x8E, B: 0x23, Opacity: 1.0},
+	"orange":               {R: 0xFF, G: 0xA5, B: 0x00, Opacity: 1.0},
+	"orangered":            {R: 0xFF, G: 0x45, B: 0x00, Opacity: 1.0},
+	"orchid":               {R: 0xDA, G: 0x70, B: 0xD6, Opacity: 1.0},
+	"palegoldenrod":        {R: 0xEE, G: 0xE8, B: 0xAA, Opacity: 1.0},
+	"palegreen":            {R: 0x98, G: 0xFB, B: 0x98, Opacity: 1.0},
+	"paleturquoise":        {R: 0xAF, G: 0xEE, B: 0xEE, Opacity: 1.0},
+	"palevioletred":        {R: 0xDB, G: 0x70, B: 0x93, Opacity: 1.0},
+	"papayawhip":           {R: 0xFF, G: 0xEF, B: 0xD5, Opacity: 1.0},
+	"peachpuff":            {R: 0xFF, G: 0xDA, B: 0xB9, Opacity: 1.0},
+	"peru":                 {R: 0xCD, G: 0x85, B: 0x3F, Opacity: 1.0},
+	"pink":                 {R: 0xFF, G: 0xC0, B: 0xCB, Opacity: 1.0},
+	"plum":                 {R: 0xDD, G: 0xA0, B: 0xDD, Opacity: 1.0},
+	"powderblue":           {R: 0xB0, G: 0xE0, B: 0xE6, Opacity: 1.0},
+	"purple":               {R: 0x80, G: 0x00, B: 0x80, Opacity: 1.0},
+	"rebeccapurple":        {R: 0x66, G: 0x33, B: 0x99, Opacity: 1.0},
+	"red":                  {R: 0xFF, G: 0x00, B: 0x00, Opacity: 1.0},
+	"rosybrown":            {R: 0xBC, G: 0x8F, B: 0x8F, Opacity: 1.0},
+	"royalblue":            {R: 0x41, G: 0x69, B: 0xE1, Opacity: 1.0},
+	"saddlebrown":          {R: 0x8B, G: 0x45, B: 0x13, Opacity: 1.0},
+	"salmon":               {R: 0xFA, G: 0x80, B: 0x72, Opacity: 1.0},
+	"sandybrown":           {R: 0xF4, G: 0xA4, B: 0x60, Opacity: 1.0},
+	"seagreen":             {R: 0x2E, G: 0x8B, B: 0x57, Opacity: 1.0},
+	"seashell":             {R: 0xFF, G: 0xF5, B: 0xEE, Opacity: 1.0},
+	"sienna":               {R: 0xA0, G: 0x52, B: 0x2D, Opacity: 1.0},
+	"silver":               {R: 0xC0, G: 0xC0, B: 0xC0, Opacity: 1.0},
+	"skyblue":              {R: 0x87, G: 0xCE, B: 0xEB, Opacity: 1.0},
+	"slateblue":            {R: 0x6A, G: 0x5A, B: 0xCD, Opacity: 1.0},
+	"slategray":            {R: 0x70, G: 0x80, B: 0x90, Opacity: 1.0},
+	"slategrey":            {R: 0x70, G: 0x80, B: 0x90, Opacity: 1.0},
+	"snow":                 {R: 0xFF, G: 0xFA, B: 0xFA, Opacity: 1.0},
+	"springgreen":          {R: 0x00, G: 0xFF, B: 0x7F, Opacity: 1.0},
+	"steelblue":            {R: 0x46, G: 0x82, B: 0xB4, Opacity: 1.0},
+	"tan":                  {R: 0xD2, G: 0xB4, B: 0x8C, Opacity: 1.0},
+	"teal":                 {R: 0x00, G: 0x80, B: 0x80, Opacity: 1.0},
+	"thistle":              {R: 0xD8, G: 0xBF, B: 0xD8, Opacity: 1.0},
+	"tomato":               {R: 0xFF, G: 0x63, B: 0x47, Opacity: 1.0},
+	"turquoise":            {R: 0x40, G: 0xE0, B: 0xD0, Opacity: 1.0},
+	"violet":               {R: 0xEE, G: 0x82, B: 0xEE, Opacity: 1.0},
+	"wheat":                {R: 0xF5, G: 0xDE, B: 0xB3, Opacity: 1.0},
+	"white":                {R: 0xFF, G: 0xFF, B: 0xFF, Opacity: 1.0},
+	"whitesmoke":           {R: 0xF5, G: 0xF5, B: 0xF5, Opacity: 1.0},
+	"yellow":               {R: 0xFF, G: 0xFF, B: 0x00, Opacity: 1.0},
+	"yellowgreen":          {R: 0x9A, G: 0xCD, B: 0x32, Opacity: 1.0},
+}
+
+// cssNamesByColor maps colors to their name. Colors with more than one name map to the one that sorts first.
+var cssNamesByColor = func() map[CSS]string {
+	names := make([]string, 0, len(cssNames))
+	for name := range cssNames {
+		names = append(names, name)
+	}
+	sort.Sort(sort.Reverse(sort.StringSlice(names)))
+
+	m := make(map[CSS]string, len(names))
+	for _, name := range names {
+		m[cssNames[name]] = name
+	}
+
+	return m
+}()
+
+// NamedCSS returns the color of a CSS named color such as "rebeccapurple", or "transparent". The name is
+// case-insensitive. The bool is false if there is no color with that name.
+func NamedCSS(name string) (CSS, bool) {
+	c, ok := cssNames[strings.ToLower(name)]
+	return c, ok
+}
+
+// Name returns the CSS name of the color if one matches it exactly, including its opacity. Colors with several names,
+// such as "aqua" and "cyan", get the name that sorts first.
+func (c CSS) Name() (string, bool) {
+	name, ok := cssNamesByColor[CSS{R: c.R, G: c.G, B: c.B, Opacity: c.SanitizedOpacity()}]
+	return name, ok
+}
+
+// NearestName returns the name of the opaque named color that is perceptually closest to the color, the one with the
+// smallest Euclidean distance in the OKLab color space. Opacity is ignored.
+func (c CSS) NearestName() string {
+	var nearest string
+
+	l1, a1, b1, _ := RGBAToOKLab(c.R, c.G, c.B, math.MaxUint8)
+	best := math.Inf(1)
+	for named, name := range cssNamesByColor {
+		if named.Opacity == 0 {
+			continue
+		}
+
+		l2, a2, b2, _ := RGBAToOKLab(named.R, named.G, named.B, math.MaxUint8)
+		d := math.Hypot(math.Hypot(l1-l2, a1-a2), b1-b2)
+		if d < best || d == best && name < nearest {
+			nearest, best = name, d
+		}
+	}
+
+	return nearest
+}
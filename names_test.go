@@ -0,0 +1,136 @@
+package colorx
+
+import (
+	"testing"
+)
+
+func TestNamedCSS(t *testing.T) {
+	tests := []struct {
+		name   string
+		want   CSS
+		wantOk bool
+	}{
+		{
+			name:   "rebeccapurple",
+			want:   CSS{R: 0x66, G: 0x33, B: 0x99, Opacity: 1.0},
+			wantOk: true,
+		},
+		{
+			name:   "AliceBlue",
+			want:   CSS{R: 0xF0, G: 0xF8, B: 0xFF, Opacity: 1.0},
+			wantOk: true,
+		},
+		{
+			name:   "transparent",
+			want:   CSS{},
+			wantOk: true,
+		},
+		{
+			name: "bluish",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, ok := NamedCSS(tt.name)
+			if ok != tt.wantOk {
+				t.Errorf("NamedCSS() ok = %v, want %v", ok, tt.wantOk)
+			}
+			if got != tt.want {
+				t.Errorf("NamedCSS() got = %v, want %v", got, tt.want)
+			}
+		})
+	}
+
+	if n := len(cssNames) - 1; n != 148 {
+		t.Errorf("len(cssNames) = %d named colors, want 148", n)
+	}
+}
+
+func TestCSS_Name(t *testing.T) {
+	tests := []struct {
+		name   string
+		c      CSS
+		want   string
+		wantOk bool
+	}{
+		{
+			name:   "teal",
+			c:      CSS{R: 0x00, G: 0x80, B: 0x80, Opacity: 1.0},
+			want:   "teal",
+			wantOk: true,
+		},
+		{
+			name:   "aqua",
+			c:      CSS{R: 0x00, G: 0xFF, B: 0xFF, Opacity: 1.0},
+			want:   "aqua",
+			wantOk: true,
+		},
+		{
+			name:   "gray",
+			c:      CSS{R: 0x80, G: 0x80, B: 0x80, Opacity: 1.0},
+			want:   "gray",
+			wantOk: true,
+		},
+		{
+			name:   "transparent",
+			c:      CSS{},
+			want:   "transparent",
+			wantOk: true,
+		},
+		{
+			name: "translucent",
+			c:    CSS{R: 0x00, G: 0x80, B: 0x80, Opacity: 0.5},
+		},
+		{
+			name: "unnamed",
+			c:    CSS{R: 0x01, G: 0x80, B: 0x80, Opacity: 1.0},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, ok := tt.c.Name()
+			if ok != tt.wantOk {
+				t.Errorf("Name() ok = %v, want %v", ok, tt.wantOk)
+			}
+			if got != tt.want {
+				t.Errorf("Name() got = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestCSS_NearestName(t *testing.T) {
+	tests := []struct {
+		name string
+		c    CSS
+		want string
+	}{
+		{
+			name: "exact",
+			c:    CSS{R: 0x66, G: 0x33, B: 0x99, Opacity: 1.0},
+			want: "rebeccapurple",
+		},
+		{
+			name: "near_teal",
+			c:    CSS{R: 0x02, G: 0x7F, B: 0x82, Opacity: 1.0},
+			want: "teal",
+		},
+		{
+			name: "perceptual",
+			c:    CSS{B: 0x33, Opacity: 1.0},
+			want: "midnightblue",
+		},
+		{
+			name: "near_black",
+			c:    CSS{R: 0x03, G: 0x02, B: 0x01},
+			want: "black",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.c.NearestName(); got != tt.want {
+				t.Errorf("NearestName() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
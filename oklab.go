@@ -0,0 +1,91 @@
+package colorx
+
+import (
+	"image/color"
+	"math"
+
+	"github.com/somebadcode/go-colorx/v2/internal/mathx"
+)
+
+// linearSRGBToLMS converts linear-light sRGB to the cone responses that OKLab is built on.
+var linearSRGBToLMS = mathx.Matrix3{
+	{0.4122214708, 0.5363325363, 0.0514459929},
+	{0.2119034982, 0.6806995451, 0.1073969566},
+	{0.0883024619, 0.2817188376, 0.6299787005},
+}
+
+// lmsToOKLab converts non-linear cone responses to OKLab.
+var lmsToOKLab = mathx.Matrix3{
+	{0.2104542553, 0.7936177850, -0.0040720468},
+	{1.9779984951, -2.4285922050, 0.4505937099},
+	{0.0259040371, 0.7827717662, -0.8086757660},
+}
+
+var (
+	lmsToLinearSRGB = linearSRGBToLMS.Inverse()
+	okLabToLMS      = lmsToOKLab.Inverse()
+)
+
+// OKLab is an implementation of the Oklab color model by Björn Ottosson. Like Lab it is designed to be perceptually
+// uniform, but it predicts lightness, chroma and hue better, especially for blue colors.
+type OKLab struct {
+	L     float64 // Lightness ∈ [0, 1]
+	A     float64 // Green (negative) to red (positive), roughly ∈ [-0.4, 0.4]
+	B     float64 // Blue (negative) to yellow (positive), roughly ∈ [-0.4, 0.4]
+	Alpha float64 // Alpha ∈ [0, 1]
+}
+
+// OKLabModel can convert the color to the OKLab color model defined in this package.
+var OKLabModel = color.ModelFunc(oklabModel)
+
+func oklabModel(c color.Color) color.Color {
+	return convertOKLab(c)
+}
+
+func convertOKLab(c color.Color) OKLab {
+	if lab, ok := c.(OKLab); ok {
+		return lab
+	}
+
+	return okLabFromLinear(toLinearSRGB(c))
+}
+
+// RGBAToOKLab converts RGBA to Lightness, a, b and Alpha.
+func RGBAToOKLab(r, g, b, a uint8) (float64, float64, float64, float64) {
+	lab := okLabFromLinear(linearize(float64(r)/math.MaxUint8), linearize(float64(g)/math.MaxUint8),
+		linearize(float64(b)/math.MaxUint8), float64(a)/math.MaxUint8)
+
+	return lab.L, lab.A, lab.B, lab.Alpha
+}
+
+// RGBA returns the alpha-premultiplied red, green, blue and alpha values for the color.
+func (lab OKLab) RGBA() (r, g, b, a uint32) {
+	return premultiplyLinear(lab.linear())
+}
+
+func (lab OKLab) linear() (r, g, b, a float64) {
+	lms := okLabToLMS.MulVec(mathx.Vector3{orZero(lab.L), orZero(lab.A), orZero(lab.B)})
+	for i, v := range lms {
+		lms[i] = v * v * v
+	}
+
+	rgb := lmsToLinearSRGB.MulVec(lms)
+
+	return rgb[0], rgb[1], rgb[2], orZero(lab.Alpha)
+}
+
+// String returns the color in the CSS oklab() function, such as "oklab(0.5 0.1 -0.1 / 0.8)".
+func (lab OKLab) String() string {
+	return functionString("oklab(", lab.L, lab.A, lab.B, lab.Alpha)
+}
+
+func okLabFromLinear(r, g, b, a float64) OKLab {
+	lms := linearSRGBToLMS.MulVec(mathx.Vector3{r, g, b})
+	for i, v := range lms {
+		lms[i] = math.Cbrt(v)
+	}
+
+	lab := lmsToOKLab.MulVec(lms)
+
+	return OKLab{L: lab[0], A: lab[1], B: lab[2], Alpha: a}
+}
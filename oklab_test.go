@@ -0,0 +1,132 @@
+package colorx
+
+import (
+	"image/color"
+	"testing"
+
+	"github.com/somebadcode/go-colorx/v2/internal/mathx"
+)
+
+func TestOKLabModel(t *testing.T) {
+	type args struct {
+		c color.Color
+	}
+	tests := []struct {
+		name string
+		args args
+		want OKLab
+	}{
+		{
+			name: "white",
+			args: args{c: color.White},
+			want: OKLab{L: 1.0, Alpha: 1.0},
+		},
+		{
+			name: "red",
+			args: args{c: color.RGBA{R: 0xFF, A: 0xFF}},
+			want: OKLab{L: 0.62796, A: 0.22486, B: 0.12585, Alpha: 1.0},
+		},
+		{
+			name: "lime_half",
+			args: args{c: color.NRGBA{G: 0xFF, A: 0x80}},
+			want: OKLab{L: 0.86644, A: -0.23389, B: 0.17950, Alpha: 0.50196},
+		},
+		{
+			name: "oklab",
+			args: args{c: OKLab{L: 0.5, A: 0.1, B: -0.1, Alpha: 0.5}},
+			want: OKLab{L: 0.5, A: 0.1, B: -0.1, Alpha: 0.5},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, ok := OKLabModel.Convert(tt.args.c).(OKLab)
+			if !ok {
+				t.Fatalf("OKLabModel.Convert() got = %T, want %T", got, tt.want)
+			}
+
+			if !mathx.EqualP(got.L, tt.want.L, 1e-4) {
+				t.Errorf("OKLabModel.Convert() got L = %f, want %f", got.L, tt.want.L)
+			}
+			if !mathx.EqualP(got.A, tt.want.A, 1e-4) {
+				t.Errorf("OKLabModel.Convert() got A = %f, want %f", got.A, tt.want.A)
+			}
+			if !mathx.EqualP(got.B, tt.want.B, 1e-4) {
+				t.Errorf("OKLabModel.Convert() got B = %f, want %f", got.B, tt.want.B)
+			}
+			if !mathx.EqualP(got.Alpha, tt.want.Alpha, 1e-4) {
+				t.Errorf("OKLabModel.Convert() got Alpha = %f, want %f", got.Alpha, tt.want.Alpha)
+			}
+		})
+	}
+}
+
+func TestOKLab_RGBA(t *testing.T) {
+	tests := []struct {
+		name  string
+		lab   OKLab
+		wantR uint32
+		wantG uint32
+		wantB uint32
+		wantA uint32
+	}{
+		{
+			name:  "white",
+			lab:   OKLab{L: 1.0, Alpha: 1.0},
+			wantR: 0xFFFF,
+			wantG: 0xFFFF,
+			wantB: 0xFFFF,
+			wantA: 0xFFFF,
+		},
+		{
+			name:  "red",
+			lab:   OKLab{L: 0.6279553606, A: 0.2248630611, B: 0.1258462985, Alpha: 1.0},
+			wantR: 0xFFFF,
+			wantA: 0xFFFF,
+		},
+		{
+			name: "transparent",
+			lab:  OKLab{L: 1.0},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			gotR, gotG, gotB, gotA := tt.lab.RGBA()
+			if !mathx.EqualP(float64(gotR), float64(tt.wantR), 2) {
+				t.Errorf("RGBA() gotR = %v, want %v", gotR, tt.wantR)
+			}
+			if !mathx.EqualP(float64(gotG), float64(tt.wantG), 2) {
+				t.Errorf("RGBA() gotG = %v, want %v", gotG, tt.wantG)
+			}
+			if !mathx.EqualP(float64(gotB), float64(tt.wantB), 2) {
+				t.Errorf("RGBA() gotB = %v, want %v", gotB, tt.wantB)
+			}
+			if gotA != tt.wantA {
+				t.Errorf("RGBA() gotA = %v, want %v", gotA, tt.wantA)
+			}
+		})
+	}
+}
+
+func TestRGBAToOKLab(t *testing.T) {
+	gotL, gotA, gotB, gotAlpha := RGBAToOKLab(0x00, 0x00, 0xFF, 0xFF)
+	if !mathx.EqualP(gotL, 0.45201, 1e-4) {
+		t.Errorf("RGBAToOKLab() got L = %f, want %f", gotL, 0.45201)
+	}
+	if !mathx.EqualP(gotA, -0.03246, 1e-4) {
+		t.Errorf("RGBAToOKLab() got A = %f, want %f", gotA, -0.03246)
+	}
+	if !mathx.EqualP(gotB, -0.31153, 1e-4) {
+		t.Errorf("RGBAToOKLab() got B = %f, want %f", gotB, -0.31153)
+	}
+	if gotAlpha != 1.0 {
+		t.Errorf("RGBAToOKLab() got Alpha = %f, want %f", gotAlpha, 1.0)
+	}
+}
+
+func BenchmarkOKLab_RGBA(b *testing.B) {
+	lab := OKLab{L: 0.5, A: 0.1, B: -0.1, Alpha: 1.0}
+
+	for n := 0; n < b.N; n++ {
+		lab.RGBA()
+	}
+}
@@ -0,0 +1,71 @@
+package colorx
+
+import (
+	"image/color"
+	"math"
+)
+
+// OKLCh is an implementation of the OKLCh color model, the cylindrical form of OKLab. It is the model used by CSS to
+// build color scales, since rotating the hue keeps the perceived lightness. A component can be NaN to mark it as
+// missing, like the CSS keyword "none", and converts as zero.
+type OKLCh struct {
+	L     float64 // Lightness ∈ [0, 1]
+	C     float64 // Chroma ≥ 0, roughly ≤ 0.4 for colors in the sRGB gamut
+	H     float64 // Hue ∈ [0, 360)
+	Alpha float64 // Alpha ∈ [0, 1]
+}
+
+// OKLChModel can convert the color to the OKLCh color model defined in this package.
+var OKLChModel = color.ModelFunc(oklchModel)
+
+func oklchModel(c color.Color) color.Color {
+	if _, ok := c.(OKLCh); ok {
+		return c
+	}
+
+	return okLChFromOKLab(convertOKLab(c))
+}
+
+// RGBAToOKLCh converts RGBA to Lightness, Chroma, Hue and Alpha.
+func RGBAToOKLCh(r, g, b, a uint8) (float64, float64, float64, float64) {
+	l, labA, labB, alpha := RGBAToOKLab(r, g, b, a)
+	c, h := toPolar(labA, labB)
+
+	return l, c, h, alpha
+}
+
+// RGBA returns the alpha-premultiplied red, green, blue and alpha values for the color.
+func (lch OKLCh) RGBA() (r, g, b, a uint32) {
+	return premultiplyLinear(lch.linear())
+}
+
+func (lch OKLCh) linear() (r, g, b, a float64) {
+	return lch.okLab().linear()
+}
+
+// MarkPowerless returns the color with the hue marked as missing if it is powerless, because the chroma is about zero.
+func (lch OKLCh) MarkPowerless() OKLCh {
+	if lch.C < oklchAchromatic {
+		lch.H = math.NaN()
+	}
+
+	return lch
+}
+
+// String returns the color in the CSS oklch() function, such as "oklch(0.5 0.1 120 / 0.8)".
+func (lch OKLCh) String() string {
+	return functionString("oklch(", lch.L, lch.C, lch.H, lch.Alpha)
+}
+
+// okLab converts the color to OKLab.
+func (lch OKLCh) okLab() OKLab {
+	a, b := fromPolar(orZero(lch.C), orZero(lch.H))
+
+	return OKLab{L: lch.L, A: a, B: b, Alpha: lch.Alpha}
+}
+
+func okLChFromOKLab(lab OKLab) OKLCh {
+	c, h := toPolar(lab.A, lab.B)
+
+	return OKLCh{L: lab.L, C: c, H: h, Alpha: lab.Alpha}
+}
@@ -0,0 +1,82 @@
+package colorx
+
+import (
+	"image/color"
+	"testing"
+
+	"github.com/somebadcode/go-colorx/v2/internal/mathx"
+)
+
+func TestOKLChModel(t *testing.T) {
+	type args struct {
+		c color.Color
+	}
+	tests := []struct {
+		name string
+		args args
+		want OKLCh
+	}{
+		{
+			name: "blue",
+			args: args{c: color.RGBA{B: 0xFF, A: 0xFF}},
+			want: OKLCh{L: 0.45201, C: 0.31321, H: 264.052, Alpha: 1.0},
+		},
+		{
+			name: "rebeccapurple",
+			args: args{c: CSS{R: 0x66, G: 0x33, B: 0x99, Opacity: 1.0}},
+			want: OKLCh{L: 0.44027, C: 0.16030, H: 303.373, Alpha: 1.0},
+		},
+		{
+			name: "oklch",
+			args: args{c: OKLCh{L: 0.5, C: 0.1, H: 180.0, Alpha: 0.5}},
+			want: OKLCh{L: 0.5, C: 0.1, H: 180.0, Alpha: 0.5},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, ok := OKLChModel.Convert(tt.args.c).(OKLCh)
+			if !ok {
+				t.Fatalf("OKLChModel.Convert() got = %T, want %T", got, tt.want)
+			}
+
+			if !mathx.EqualP(got.L, tt.want.L, 1e-3) {
+				t.Errorf("OKLChModel.Convert() got L = %f, want %f", got.L, tt.want.L)
+			}
+			if !mathx.EqualP(got.C, tt.want.C, 1e-3) {
+				t.Errorf("OKLChModel.Convert() got C = %f, want %f", got.C, tt.want.C)
+			}
+			if !mathx.EqualP(got.H, tt.want.H, 1e-2) {
+				t.Errorf("OKLChModel.Convert() got H = %f, want %f", got.H, tt.want.H)
+			}
+			if !mathx.EqualP(got.Alpha, tt.want.Alpha, 1e-3) {
+				t.Errorf("OKLChModel.Convert() got Alpha = %f, want %f", got.Alpha, tt.want.Alpha)
+			}
+		})
+	}
+}
+
+func TestOKLCh_RGBA(t *testing.T) {
+	// Rotating the hue of red by 360° must give red again.
+	lch := OKLCh{L: 0.6279553606, C: 0.2576833130, H: 29.2338851 + 360.0, Alpha: 1.0}
+
+	gotR, gotG, gotB, gotA := lch.RGBA()
+	if gotR < 0xFFFE || gotG > 1 || gotB > 1 || gotA != 0xFFFF {
+		t.Errorf("RGBA() = %v, %v, %v, %v, want red", gotR, gotG, gotB, gotA)
+	}
+}
+
+func TestRGBAToOKLCh(t *testing.T) {
+	gotL, gotC, gotH, gotA := RGBAToOKLCh(0xFF, 0x00, 0x00, 0x80)
+	if !mathx.EqualP(gotL, 0.62796, 1e-4) {
+		t.Errorf("RGBAToOKLCh() got L = %f, want %f", gotL, 0.62796)
+	}
+	if !mathx.EqualP(gotC, 0.25768, 1e-4) {
+		t.Errorf("RGBAToOKLCh() got C = %f, want %f", gotC, 0.25768)
+	}
+	if !mathx.EqualP(gotH, 29.234, 1e-2) {
+		t.Errorf("RGBAToOKLCh() got H = %f, want %f", gotH, 29.234)
+	}
+	if !mathx.EqualP(gotA, 0.5, 1e-2) {
+		t.Errorf("RGBAToOKLCh() got A = %f, want %f", gotA, 0.5)
+	}
+}
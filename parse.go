@@ -0,0 +1,793 @@
+package colorx
+
+import (
+	"errors"
+	"fmt"
+	"image/color"
+	"math"
+	"strings"
+
+	"github.com/somebadcode/go-colorx/v2/internal/mathx"
+)
+
+// Errors wrapped by ParseError that describe why a color could not be parsed.
+var (
+	ErrSyntax          = errors.New("invalid syntax")
+	ErrUnexpectedEnd   = errors.New("unexpected end of input")
+	ErrInvalidHex      = errors.New("invalid hexadecimal color")
+	ErrInvalidValue    = errors.New("invalid value")
+	ErrUnknownName     = errors.New("unknown color name")
+	ErrUnknownFunction = errors.New("unknown color function")
+	ErrUnknownSpace    = errors.New("unknown color space")
+	ErrInvalidVariable = errors.New("undefined or cyclic variable")
+)
+
+// ParseError is returned when a color can not be parsed. It records where in the input the problem was found.
+type ParseError struct {
+	Input  string // Input is the text being parsed.
+	Offset int    // Offset is the byte offset in Input where the problem was found.
+	Err    error  // Err is the reason parsing failed.
+}
+
+func (e *ParseError) Error() string {
+	return fmt.Sprintf("colorx: parsing %q: %v at offset %d", e.Input, e.Err, e.Offset)
+}
+
+func (e *ParseError) Unwrap() error {
+	return e.Err
+}
+
+// ParseCSS parses a color in any of the notations of CSS Color Module Level 4 and returns it as CSS. The sRGB notations
+// are hexadecimal ("#rgb", "#rgba", "#rrggbb" and "#rrggbbaa"), "rgb()", "rgba()", "hsl()", "hsla()" and "hwb()" in
+// both the legacy comma separated and the modern space separated syntax, "transparent" and named colors. Colors in the
+// other notations that Parse understands, including relative colors and var() references, are clipped to the sRGB
+// gamut. Keywords and function names are case-insensitive. The returned error is a *ParseError.
+func ParseCSS(s string, opts ...ParseOption) (CSS, error) {
+	c, err := Parse(s, opts...)
+	if err != nil {
+		return CSS{}, err
+	}
+
+	return cssModel(c).(CSS), nil
+}
+
+// Parse parses a color in any of the notations of CSS Color Module Level 4 and returns it as the type that holds it
+// without loss: CSS for the sRGB notations that ParseCSS understands, SRGB, LinearSRGB, DisplayP3, Rec2020, A98RGB,
+// ProPhotoRGB or XYZ for the "color()" function, Lab and LCh relative to D50 for "lab()" and "lch()", and OKLab and
+// OKLCh for "oklab()" and "oklch()". A component given as the keyword "none" is missing and is stored as NaN, which
+// converts as zero and is written back as "none" by the String methods.
+//
+// Relative colors, such as "oklch(from #0af calc(l * 0.8) c h)", are evaluated to static colors: the channel keywords
+// of the function take the values of the origin color, converted to the space of the function, and calc() can do
+// arithmetic with them. References to custom properties with var() are resolved with WithVarResolver. The returned
+// error is a *ParseError.
+func Parse(s string, opts ...ParseOption) (color.Color, error) {
+	var o parseOptions
+	for _, opt := range opts {
+		opt(&o)
+	}
+
+	tokens, err := tokenize(s)
+	if err != nil {
+		return nil, err
+	}
+
+	if tokens, err = substitute(s, tokens, o.resolve, nil); err != nil {
+		return nil, err
+	}
+
+	p := parser{input: s, tokens: tokens, exact: o.exact}
+
+	c, err := p.parseColor()
+	if err != nil {
+		return nil, err
+	}
+
+	if t := p.next(); t.kind != tokenEOF {
+		return nil, p.error(t, ErrSyntax)
+	}
+
+	return c, nil
+}
+
+// ParseOption configures Parse and ParseCSS.
+type ParseOption func(*parseOptions)
+
+type parseOptions struct {
+	resolve func(name string) (string, bool)
+	exact   bool
+}
+
+// WithVarResolver makes var() references resolve with resolve, which returns the value of the custom property with the
+// name, including the leading "--", or false if it is not defined. Without a resolver, every var() uses its fallback.
+func WithVarResolver(resolve func(name string) (value string, ok bool)) ParseOption {
+	return func(o *parseOptions) {
+		o.resolve = resolve
+	}
+}
+
+// exactly makes rgb(), hsl() and hwb() return SRGB, HSLA and HWBA instead of CSS, so that the components are not
+// rounded to 8 bits and a component given as "none" is kept as NaN. It is used to unmarshal the float color types.
+func exactly() ParseOption {
+	return func(o *parseOptions) {
+		o.exact = true
+	}
+}
+
+// substitute replaces the var() references in tokens with the tokens of the value of the custom property, or of the
+// fallback if it is not defined. The substituted tokens get the offset of the reference. seen holds the names of the
+// custom properties that are being substituted, to detect cycles.
+func substitute(input string, tokens []token, resolve func(string) (string, bool), seen []string) ([]token, error) {
+	out := make([]token, 0, len(tokens))
+
+	for i := 0; i < len(tokens); i++ {
+		ref := tokens[i]
+		if ref.kind != tokenFunction || ref.text != "var" {
+			out = append(out, ref)
+			continue
+		}
+
+		name := tokens[i+1]
+		if name.kind != tokenIdent || !strings.HasPrefix(name.text, "--") {
+			return nil, &ParseError{Input: input, Offset: name.pos, Err: ErrSyntax}
+		}
+
+		// Find the closing parenthesis of the reference, the fallback is everything after the first comma.
+		end, depth, fallback := i+2, 0, -1
+		for ; ; end++ {
+			t := tokens[end]
+			if t.kind == tokenEOF {
+				return nil, &ParseError{Input: input, Offset: t.pos, Err: ErrUnexpectedEnd}
+			}
+
+			if t.kind == tokenFunction || t.kind == tokenOpenParen {
+				depth++
+			} else if t.kind == tokenCloseParen {
+				if depth == 0 {
+					break
+				}
+				depth--
+			} else if t.kind == tokenComma && depth == 0 && fallback < 0 {
+				fallback = end + 1
+			}
+		}
+
+		if fallback < 0 && end != i+2 {
+			return nil, &ParseError{Input: input, Offset: tokens[i+2].pos, Err: ErrSyntax}
+		}
+
+		value, err := resolveVariable(name.text, resolve, seen)
+		if errors.Is(err, ErrInvalidVariable) && fallback >= 0 {
+			value, err = append(tokens[fallback:end:end], token{kind: tokenEOF}), nil
+		}
+		if err != nil {
+			return nil, &ParseError{Input: input, Offset: ref.pos, Err: err}
+		}
+
+		if value, err = substitute(input, value, resolve, append(seen, name.text)); err != nil {
+			return nil, err
+		}
+
+		for _, t := range value[:len(value)-1] {
+			t.pos = ref.pos
+			out = append(out, t)
+		}
+
+		i = end
+	}
+
+	return out, nil
+}
+
+// resolveVariable returns the tokens of the value of the custom property, or ErrInvalidVariable if it is not defined
+// or refers to itself.
+func resolveVariable(name string, resolve func(string) (string, bool), seen []string) ([]token, error) {
+	for _, s := range seen {
+		if s == name {
+			return nil, ErrInvalidVariable
+		}
+	}
+
+	if resolve == nil {
+		return nil, ErrInvalidVariable
+	}
+
+	value, ok := resolve(name)
+	if !ok {
+		return nil, ErrInvalidVariable
+	}
+
+	tokens, err := tokenize(value)
+	if err != nil {
+		var perr *ParseError
+		if errors.As(err, &perr) {
+			return nil, perr.Err
+		}
+		return nil, err
+	}
+
+	return tokens, nil
+}
+
+// parser is a recursive descent parser of tokenized CSS color text.
+type parser struct {
+	input    string
+	tokens   []token
+	i        int
+	channels map[string]float64 // Channel keywords of the relative color syntax and their values.
+	exact    bool               // Return the sRGB notations without rounding, see exactly.
+}
+
+// arguments are the components of a color function. The alpha token has kind tokenEOF if it was omitted.
+type arguments struct {
+	components []token
+	alpha      token
+	legacy     bool
+}
+
+func (p *parser) peek() token {
+	return p.tokens[p.i]
+}
+
+func (p *parser) next() token {
+	t := p.tokens[p.i]
+	if t.kind != tokenEOF {
+		p.i++
+	}
+
+	return t
+}
+
+func (p *parser) error(t token, err error) *ParseError {
+	if t.kind == tokenEOF && errors.Is(err, ErrSyntax) {
+		err = ErrUnexpectedEnd
+	}
+
+	return &ParseError{Input: p.input, Offset: t.pos, Err: err}
+}
+
+func (p *parser) expect(kind tokenKind) error {
+	if t := p.next(); t.kind != kind {
+		return p.error(t, ErrSyntax)
+	}
+
+	return nil
+}
+
+func (p *parser) parseColor() (color.Color, error) {
+	t := p.next()
+
+	switch t.kind {
+	case tokenHash:
+		return p.parseHex(t)
+
+	case tokenIdent:
+		c, ok := cssNames[t.text]
+		if !ok {
+			return nil, p.error(t, ErrUnknownName)
+		}
+		return c, nil
+
+	case tokenFunction:
+		switch t.text {
+		case "rgb", "rgba":
+			return p.parseRGB()
+
+		case "hsl", "hsla":
+			return p.parseHSL()
+
+		case "hwb":
+			return p.parseHWB()
+
+		case "color":
+			return p.parseColorFunction()
+
+		case "lab":
+			return p.parseLab(SpaceLab, 100, 125, func(l, a, b, alpha float64) color.Color {
+				return Lab{L: l, A: a, B: b, Alpha: alpha, White: D50}
+			})
+
+		case "oklab":
+			return p.parseLab(SpaceOKLab, 1, 0.4, func(l, a, b, alpha float64) color.Color {
+				return OKLab{L: l, A: a, B: b, Alpha: alpha}
+			})
+
+		case "lch":
+			return p.parseLCh(SpaceLCh, 100, 150, func(l, c, h, alpha float64) color.Color {
+				return LCh{L: l, C: c, H: h, Alpha: alpha, White: D50}
+			})
+
+		case "oklch":
+			return p.parseLCh(SpaceOKLCh, 1, 0.4, func(l, c, h, alpha float64) color.Color {
+				return OKLCh{L: l, C: c, H: h, Alpha: alpha}
+			})
+		}
+		return nil, p.error(t, ErrUnknownFunction)
+
+	case tokenEOF, tokenNumber, tokenPercentage, tokenDimension, tokenComma, tokenSlash, tokenCloseParen,
+		tokenOpenParen, tokenDelim:
+	}
+
+	return nil, p.error(t, ErrSyntax)
+}
+
+func (p *parser) parseHex(t token) (CSS, error) {
+	var digits [8]uint8
+
+	n := len(t.text)
+	if n != 3 && n != 4 && n != 6 && n != 8 {
+		return CSS{}, p.error(t, ErrInvalidHex)
+	}
+
+	for i := 0; i < n; i++ {
+		d, ok := hexDigit(t.text[i])
+		if !ok {
+			return CSS{}, &ParseError{Input: p.input, Offset: t.pos + 1 + i, Err: ErrInvalidHex}
+		}
+		digits[i] = d
+	}
+
+	// Expand the short forms so that every channel is two digits.
+	if n <= 4 {
+		for i := n - 1; i >= 0; i-- {
+			digits[2*i], digits[2*i+1] = digits[i], digits[i]
+		}
+		n *= 2
+	}
+
+	c := CSS{
+		R:       digits[0]<<4 | digits[1],
+		G:       digits[2]<<4 | digits[3],
+		B:       digits[4]<<4 | digits[5],
+		Opacity: 1.0,
+	}
+
+	if n == 8 {
+		c.Opacity = float64(digits[6]<<4|digits[7]) / math.MaxUint8
+	}
+
+	return c, nil
+}
+
+func (p *parser) parseRGB() (color.Color, error) {
+	origin, err := p.parseOrigin()
+	if err != nil {
+		return nil, err
+	}
+
+	args, err := p.parseArguments(3, origin, rgbChannels)
+	if err != nil {
+		return nil, err
+	}
+
+	// The legacy syntax does not allow numbers and percentages to be mixed.
+	if args.legacy {
+		for _, t := range args.components[1:] {
+			if t.kind != args.components[0].kind {
+				return nil, p.error(t, ErrInvalidValue)
+			}
+		}
+	}
+
+	var rgb [3]float64
+	for i, t := range args.components {
+		if rgb[i], err = p.fraction(t, math.MaxUint8, args.legacy); err != nil {
+			return nil, err
+		}
+	}
+
+	alpha, err := p.alpha(args)
+	if err != nil {
+		return nil, err
+	}
+
+	if p.exact {
+		return SRGB{
+			R: orMissing(args.components[0], mathx.Clamp(rgb[0], 0, 1)),
+			G: orMissing(args.components[1], mathx.Clamp(rgb[1], 0, 1)),
+			B: orMissing(args.components[2], mathx.Clamp(rgb[2], 0, 1)),
+			A: orMissing(args.alpha, alpha),
+		}, nil
+	}
+
+	return cssFromFloat(rgb[0], rgb[1], rgb[2], alpha), nil
+}
+
+func (p *parser) parseHSL() (color.Color, error) {
+	origin, err := p.parseOrigin()
+	if err != nil {
+		return nil, err
+	}
+
+	args, err := p.parseArguments(3, origin, hslChannels)
+	if err != nil {
+		return nil, err
+	}
+
+	h, err := p.hue(args.components[0], args.legacy)
+	if err != nil {
+		return nil, err
+	}
+
+	var sl [2]float64
+	for i, t := range args.components[1:] {
+		// The legacy syntax requires saturation and lightness to be percentages.
+		if args.legacy && t.kind != tokenPercentage {
+			return nil, p.error(t, ErrInvalidValue)
+		}
+		if sl[i], err = p.fraction(t, 100, args.legacy); err != nil {
+			return nil, err
+		}
+	}
+
+	alpha, err := p.alpha(args)
+	if err != nil {
+		return nil, err
+	}
+
+	if p.exact {
+		return HSLA{
+			H: orMissing(args.components[0], normalizeHue(h)),
+			S: orMissing(args.components[1], mathx.Clamp(sl[0], 0, 1)),
+			L: orMissing(args.components[2], mathx.Clamp(sl[1], 0, 1)),
+			A: orMissing(args.alpha, alpha),
+		}, nil
+	}
+
+	r, g, b := hslToRGB(normalizeHue(h), mathx.Clamp(sl[0], 0, 1), mathx.Clamp(sl[1], 0, 1))
+
+	return cssFromFloat(r, g, b, alpha), nil
+}
+
+func (p *parser) parseHWB() (color.Color, error) {
+	origin, err := p.parseOrigin()
+	if err != nil {
+		return nil, err
+	}
+
+	args, err := p.parseArguments(3, origin, hwbChannels)
+	if err != nil {
+		return nil, err
+	}
+
+	// There is no legacy syntax for hwb().
+	if args.legacy {
+		return nil, p.error(args.components[1], ErrSyntax)
+	}
+
+	h, err := p.hue(args.components[0], false)
+	if err != nil {
+		return nil, err
+	}
+
+	var wb [2]float64
+	for i, t := range args.components[1:] {
+		if wb[i], err = p.fraction(t, 100, false); err != nil {
+			return nil, err
+		}
+	}
+
+	alpha, err := p.alpha(args)
+	if err != nil {
+		return nil, err
+	}
+
+	if p.exact {
+		return HWBA{
+			H: orMissing(args.components[0], normalizeHue(h)),
+			W: orMissing(args.components[1], mathx.Clamp(wb[0], 0, 1)),
+			B: orMissing(args.components[2], mathx.Clamp(wb[1], 0, 1)),
+			A: orMissing(args.alpha, alpha),
+		}, nil
+	}
+
+	r, g, b := hwbToRGB(normalizeHue(h), mathx.Clamp(wb[0], 0, 1), mathx.Clamp(wb[1], 0, 1))
+
+	return cssFromFloat(r, g, b, alpha), nil
+}
+
+// colorSpaces are the color spaces of the CSS color() function that have a Space.
+var colorSpaces = map[string]Space{
+	"srgb":         SpaceSRGB,
+	"srgb-linear":  SpaceLinearSRGB,
+	"display-p3":   SpaceDisplayP3,
+	"rec2020":      SpaceRec2020,
+	"a98-rgb":      SpaceA98RGB,
+	"prophoto-rgb": SpaceProPhotoRGB,
+}
+
+func (p *parser) parseColorFunction() (color.Color, error) {
+	origin, err := p.parseOrigin()
+	if err != nil {
+		return nil, err
+	}
+
+	t := p.next()
+	if t.kind != tokenIdent {
+		return nil, p.error(t, ErrSyntax)
+	}
+
+	space, ok := colorSpaces[t.text]
+	white := D65
+
+	switch t.text {
+	case "xyz", "xyz-d65":
+	case "xyz-d50":
+		white = D50
+	default:
+		if !ok {
+			return nil, p.error(t, ErrUnknownSpace)
+		}
+	}
+
+	channels := xyzChannels(white)
+	if ok {
+		channels = relativeChannels(space, "r", "g", "b")
+	}
+
+	v, alpha, err := p.parseModernArguments(origin, channels, 1, 1, 1)
+	if err != nil {
+		return nil, err
+	}
+
+	if !ok {
+		return XYZ{X: v[0], Y: v[1], Z: v[2], A: alpha, White: white}, nil
+	}
+
+	return fromSpace(space, v, alpha), nil
+}
+
+// parseLab parses the arguments of lab() and oklab() in the space, where 100% is lightness and ab of the components.
+func (p *parser) parseLab(space Space, lightness, ab float64,
+	fn func(l, a, b, alpha float64) color.Color) (color.Color, error) {
+	origin, err := p.parseOrigin()
+	if err != nil {
+		return nil, err
+	}
+
+	v, alpha, err := p.parseModernArguments(origin, relativeChannels(space, "l", "a", "b"), lightness, ab, ab)
+	if err != nil {
+		return nil, err
+	}
+
+	return fn(clampPresent(v[0], 0, lightness), v[1], v[2], alpha), nil
+}
+
+// parseLCh parses the arguments of lch() and oklch() in the space, where 100% is lightness and chroma of the
+// components.
+func (p *parser) parseLCh(space Space, lightness, chroma float64,
+	fn func(l, c, h, alpha float64) color.Color) (color.Color, error) {
+	origin, err := p.parseOrigin()
+	if err != nil {
+		return nil, err
+	}
+
+	v, alpha, err := p.parseModernArguments(origin, relativeChannels(space, "l", "c", "h"), lightness, chroma,
+		math.NaN())
+	if err != nil {
+		return nil, err
+	}
+
+	return fn(clampPresent(v[0], 0, lightness), clampPresent(v[1], 0, math.Inf(1)), v[2], alpha), nil
+}
+
+// parseModernArguments parses three components and an optional alpha in the modern syntax, where 100% of a component
+// is the matching value of percent. A component with a NaN percent is a hue. Missing components are NaN. The channel
+// keywords of a relative color are those of channels for the origin, unless the origin is nil.
+func (p *parser) parseModernArguments(origin color.Color, channels channelFunc, percent ...float64) (v [3]float64,
+	alpha float64, err error) {
+	args, err := p.parseArguments(3, origin, channels)
+	if err != nil {
+		return v, 0, err
+	}
+
+	if args.legacy {
+		return v, 0, p.error(args.components[1], ErrSyntax)
+	}
+
+	for i, t := range args.components {
+		switch {
+		case t.kind == tokenIdent && t.text == "none":
+			v[i] = math.NaN()
+
+		case math.IsNaN(percent[i]):
+			v[i], err = p.hue(t, false)
+
+		case t.kind == tokenPercentage:
+			v[i] = t.value / 100 * percent[i]
+
+		case t.kind == tokenNumber:
+			v[i] = t.value
+
+		default:
+			err = p.error(t, ErrInvalidValue)
+		}
+		if err != nil {
+			return v, 0, err
+		}
+	}
+
+	if t := args.alpha; t.kind == tokenIdent && t.text == "none" {
+		return v, math.NaN(), nil
+	}
+
+	alpha, err = p.alpha(args)
+
+	return v, alpha, err
+}
+
+// parseArguments parses n components and an optional alpha, followed by the closing parenthesis. Components are
+// either separated by commas (legacy syntax) or by whitespace with alpha after a slash (modern syntax). If origin is
+// not nil, the arguments are those of a relative color: only the modern syntax is allowed, the channel keywords of
+// channels can be used in place of numbers, and the alpha of the origin is used if the alpha is omitted.
+func (p *parser) parseArguments(n int, origin color.Color, channels channelFunc) (arguments, error) {
+	args := arguments{
+		components: make([]token, 0, n),
+	}
+
+	if origin != nil {
+		// The channel keywords are only defined within the arguments of the relative color.
+		defer func(prev map[string]float64) {
+			p.channels = prev
+		}(p.channels)
+		p.channels = channels(origin)
+	}
+
+	for i := 0; i < n; i++ {
+		if i == 1 && p.peek().kind == tokenComma && origin == nil {
+			args.legacy = true
+		}
+
+		if i > 0 && args.legacy {
+			if err := p.expect(tokenComma); err != nil {
+				return arguments{}, err
+			}
+		}
+
+		t, err := p.component()
+		if err != nil {
+			return arguments{}, err
+		}
+		args.components = append(args.components, t)
+	}
+
+	separator := tokenSlash
+	if args.legacy {
+		separator = tokenComma
+	}
+
+	if p.peek().kind == separator {
+		p.next()
+
+		t, err := p.component()
+		if err != nil {
+			return arguments{}, err
+		}
+		args.alpha = t
+	} else if origin != nil {
+		args.alpha = token{kind: tokenNumber, value: p.channels["alpha"], pos: p.peek().pos}
+	}
+
+	if err := p.expect(tokenCloseParen); err != nil {
+		return arguments{}, err
+	}
+
+	return args, nil
+}
+
+func (p *parser) component() (token, error) {
+	t := p.next()
+
+	switch t.kind {
+	case tokenNumber, tokenPercentage, tokenDimension:
+		return t, nil
+
+	case tokenIdent:
+		if v, ok := p.channels[t.text]; ok {
+			return token{kind: tokenNumber, value: v, pos: t.pos}, nil
+		}
+		return t, nil
+
+	case tokenFunction:
+		if t.text == "calc" {
+			return p.calc(t)
+		}
+
+	case tokenEOF, tokenHash, tokenComma, tokenSlash, tokenCloseParen, tokenOpenParen, tokenDelim:
+	}
+
+	return token{}, p.error(t, ErrSyntax)
+}
+
+// fraction returns a number or percentage as a fraction of its full range, where a number is relative to scale. The
+// keyword "none" is zero in the modern syntax.
+func (p *parser) fraction(t token, scale float64, legacy bool) (float64, error) {
+	switch {
+	case t.kind == tokenNumber:
+		return t.value / scale, nil
+
+	case t.kind == tokenPercentage:
+		return t.value / 100, nil
+
+	case t.kind == tokenIdent && t.text == "none" && !legacy:
+		return 0, nil
+	}
+
+	return 0, p.error(t, ErrInvalidValue)
+}
+
+// hue returns a number or angle as degrees.
+func (p *parser) hue(t token, legacy bool) (float64, error) {
+	switch {
+	case t.kind == tokenNumber:
+		return t.value, nil
+
+	case t.kind == tokenDimension:
+		switch t.text {
+		case "deg":
+			return t.value, nil
+
+		case "rad":
+			return t.value * 180 / math.Pi, nil
+
+		case "grad":
+			return t.value * 0.9, nil
+
+		case "turn":
+			return t.value * 360, nil
+		}
+
+	case t.kind == tokenIdent && t.text == "none" && !legacy:
+		return 0, nil
+	}
+
+	return 0, p.error(t, ErrInvalidValue)
+}
+
+// alpha returns the alpha of the arguments clamped to [0, 1], or 1 if it was omitted.
+func (p *parser) alpha(args arguments) (float64, error) {
+	if args.alpha.kind == tokenEOF {
+		return 1.0, nil
+	}
+
+	a, err := p.fraction(args.alpha, 1, args.legacy)
+	if err != nil {
+		return 0, err
+	}
+
+	return mathx.Clamp(a, 0, 1), nil
+}
+
+// orMissing returns NaN if the token is the keyword "none", and v otherwise.
+func orMissing(t token, v float64) float64 {
+	if t.kind == tokenIdent && t.text == "none" {
+		return math.NaN()
+	}
+
+	return v
+}
+
+func hexDigit(c byte) (uint8, bool) {
+	switch {
+	case c >= '0' && c <= '9':
+		return c - '0', true
+
+	case c >= 'a' && c <= 'f':
+		return c - 'a' + 10, true
+
+	case c >= 'A' && c <= 'F':
+		return c - 'A' + 10, true
+	}
+
+	return 0, false
+}
+
+// cssFromFloat returns the CSS color with red, green, blue and opacity ∈ [0, 1], rounding to the nearest channel value.
+func cssFromFloat(r, g, b, opacity float64) CSS {
+	return CSS{
+		R:       uint8(math.Round(mathx.Clamp(r, 0, 1) * math.MaxUint8)),
+		G:       uint8(math.Round(mathx.Clamp(g, 0, 1) * math.MaxUint8)),
+		B:       uint8(math.Round(mathx.Clamp(b, 0, 1) * math.MaxUint8)),
+		Opacity: mathx.Clamp(opacity, 0, 1),
+	}
+}
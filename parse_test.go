@@ -0,0 +1,449 @@
+package colorx
+
+import (
+	"errors"
+	"fmt"
+	"image/color"
+	"math"
+	"reflect"
+	"testing"
+)
+
+func TestParseCSS(t *testing.T) {
+	type args struct {
+		s string
+	}
+	tests := []struct {
+		name    string
+		args    args
+		want    CSS
+		wantErr error
+	}{
+		{
+			name: "hex_short",
+			args: args{s: "#FB8"},
+			want: CSS{R: 0xFF, G: 0xBB, B: 0x88, Opacity: 1.0},
+		},
+		{
+			name: "hex_short_alpha",
+			args: args{s: "#fb80"},
+			want: CSS{R: 0xFF, G: 0xBB, B: 0x88},
+		},
+		{
+			name: "hex",
+			args: args{s: "#FFBF80"},
+			want: CSS{R: 0xFF, G: 0xBF, B: 0x80, Opacity: 1.0},
+		},
+		{
+			name: "hex_alpha",
+			args: args{s: "#ffbf80ff"},
+			want: CSS{R: 0xFF, G: 0xBF, B: 0x80, Opacity: 1.0},
+		},
+		{
+			name: "rgb_legacy",
+			args: args{s: "rgb(255,191,128)"},
+			want: CSS{R: 0xFF, G: 0xBF, B: 0x80, Opacity: 1.0},
+		},
+		{
+			name: "rgba_legacy",
+			args: args{s: "rgba( 255 , 191 , 128 , 0.75 )"},
+			want: CSS{R: 0xFF, G: 0xBF, B: 0x80, Opacity: 0.75},
+		},
+		{
+			name: "rgb_legacy_percentage",
+			args: args{s: "rgb(100%, 50%, 0%, 50%)"},
+			want: CSS{R: 0xFF, G: 0x80, B: 0x00, Opacity: 0.5},
+		},
+		{
+			name: "rgb_modern",
+			args: args{s: "rgb(255 191 128 / 0.75)"},
+			want: CSS{R: 0xFF, G: 0xBF, B: 0x80, Opacity: 0.75},
+		},
+		{
+			name: "rgb_modern_mixed",
+			args: args{s: "RGB(100% 191 none / 25%)"},
+			want: CSS{R: 0xFF, G: 0xBF, B: 0x00, Opacity: 0.25},
+		},
+		{
+			name: "rgb_clamped",
+			args: args{s: "rgb(300 -20 127.6 / 2)"},
+			want: CSS{R: 0xFF, G: 0x00, B: 0x80, Opacity: 1.0},
+		},
+		{
+			name: "hsl_legacy",
+			args: args{s: "hsl(120, 100%, 25%)"},
+			want: CSS{R: 0x00, G: 0x80, B: 0x00, Opacity: 1.0},
+		},
+		{
+			name: "hsla_legacy",
+			args: args{s: "hsla(240, 100%, 50%, 0.5)"},
+			want: CSS{R: 0x00, G: 0x00, B: 0xFF, Opacity: 0.5},
+		},
+		{
+			name: "hsl_modern_angle",
+			args: args{s: "hsl(0.5turn 100 50 / 50%)"},
+			want: CSS{R: 0x00, G: 0xFF, B: 0xFF, Opacity: 0.5},
+		},
+		{
+			name: "hsl_modern_rad",
+			args: args{s: "hsl(3.14159265rad 100% 50%)"},
+			want: CSS{R: 0x00, G: 0xFF, B: 0xFF, Opacity: 1.0},
+		},
+		{
+			name: "hsl_hue_below_minus_360",
+			args: args{s: "hsl(-400 100% 50%)"},
+			want: CSS{R: 0xFF, G: 0x00, B: 0xAA, Opacity: 1.0},
+		},
+		{
+			name: "hsl_hue_minus_720",
+			args: args{s: "hsl(-720deg 100% 50%)"},
+			want: CSS{R: 0xFF, G: 0x00, B: 0x00, Opacity: 1.0},
+		},
+		{
+			name: "hsl_hue_1080",
+			args: args{s: "hsl(1080 100% 50%)"},
+			want: CSS{R: 0xFF, G: 0x00, B: 0x00, Opacity: 1.0},
+		},
+		{
+			name: "hwb_hue_below_minus_360",
+			args: args{s: "hwb(-400 0% 0%)"},
+			want: CSS{R: 0xFF, G: 0x00, B: 0xAA, Opacity: 1.0},
+		},
+		{
+			name: "hwb",
+			args: args{s: "hwb(60 0% 50%)"},
+			want: CSS{R: 0x80, G: 0x80, B: 0x00, Opacity: 1.0},
+		},
+		{
+			name: "hwb_gray",
+			args: args{s: "hwb(60deg 60% 60% / 1)"},
+			want: CSS{R: 0x80, G: 0x80, B: 0x80, Opacity: 1.0},
+		},
+		{
+			name: "transparent",
+			args: args{s: "Transparent"},
+			want: CSS{},
+		},
+		{
+			name: "named",
+			args: args{s: " teal "},
+			want: CSS{R: 0x00, G: 0x80, B: 0x80, Opacity: 1.0},
+		},
+		{
+			name:    "empty",
+			args:    args{s: ""},
+			wantErr: ErrUnexpectedEnd,
+		},
+		{
+			name:    "hex_length",
+			args:    args{s: "#12345"},
+			wantErr: ErrInvalidHex,
+		},
+		{
+			name:    "hex_digit",
+			args:    args{s: "#12345g"},
+			wantErr: ErrInvalidHex,
+		},
+		{
+			name:    "unknown_name",
+			args:    args{s: "bluish"},
+			wantErr: ErrUnknownName,
+		},
+		{
+			name:    "unknown_function",
+			args:    args{s: "cmyk(0 0 0 0)"},
+			wantErr: ErrUnknownFunction,
+		},
+		{
+			name:    "unterminated",
+			args:    args{s: "rgb(1 2 3"},
+			wantErr: ErrUnexpectedEnd,
+		},
+		{
+			name:    "legacy_mixed",
+			args:    args{s: "rgb(255, 50%, 0)"},
+			wantErr: ErrInvalidValue,
+		},
+		{
+			name:    "legacy_none",
+			args:    args{s: "rgb(none, 0, 0)"},
+			wantErr: ErrInvalidValue,
+		},
+		{
+			name:    "legacy_hwb",
+			args:    args{s: "hwb(0, 0%, 0%)"},
+			wantErr: ErrSyntax,
+		},
+		{
+			name:    "hsl_number_legacy",
+			args:    args{s: "hsl(0, 100, 50)"},
+			wantErr: ErrInvalidValue,
+		},
+		{
+			name:    "hue_unit",
+			args:    args{s: "hsl(10px 100% 50%)"},
+			wantErr: ErrInvalidValue,
+		},
+		{
+			name:    "trailing",
+			args:    args{s: "red blue"},
+			wantErr: ErrSyntax,
+		},
+		{
+			name: "display_p3_clipped",
+			args: args{s: "color(display-p3 1 0 0)"},
+			want: CSS{R: 0xFF, G: 0x00, B: 0x00, Opacity: 1.0},
+		},
+		{
+			name: "oklab_none",
+			args: args{s: "oklab(none none none)"},
+			want: CSS{R: 0x00, G: 0x00, B: 0x00, Opacity: 1.0},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := ParseCSS(tt.args.s)
+			if !errors.Is(err, tt.wantErr) {
+				t.Errorf("ParseCSS() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if got != tt.want {
+				t.Errorf("ParseCSS() got = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestParseCSS_offset(t *testing.T) {
+	tests := []struct {
+		name string
+		s    string
+		want int
+	}{
+		{
+			name: "hex_digit",
+			s:    "#12345g",
+			want: 6,
+		},
+		{
+			name: "function",
+			s:    "  cmyk(0 0 0 0)",
+			want: 2,
+		},
+		{
+			name: "component",
+			s:    "rgb(1 2 #3)",
+			want: 8,
+		},
+		{
+			name: "end",
+			s:    "rgb(1, 2, 3",
+			want: 11,
+		},
+		{
+			name: "character",
+			s:    "rgb(1 2 3 ! 4)",
+			want: 10,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := ParseCSS(tt.s)
+
+			var perr *ParseError
+			if !errors.As(err, &perr) {
+				t.Fatalf("ParseCSS() error = %v, want %T", err, perr)
+			}
+			if perr.Offset != tt.want {
+				t.Errorf("ParseCSS() offset = %d, want %d", perr.Offset, tt.want)
+			}
+		})
+	}
+}
+
+func TestParseCSS_roundTrip(t *testing.T) {
+	colors := []CSS{
+		{R: 0xFF, G: 0xBF, B: 0x80, Opacity: 1.0},
+		{R: 0x00, G: 0x00, B: 0x80, Opacity: 0.5},
+		{R: 0x00, G: 0x00, B: 0x80, Opacity: 0.54},
+		{R: 0x12, G: 0x34, B: 0x56, Opacity: 0.0},
+	}
+	for _, c := range colors {
+		t.Run(c.HexString(), func(t *testing.T) {
+			got, err := ParseCSS(c.String())
+			if err != nil {
+				t.Fatalf("ParseCSS(%q) error = %v", c.String(), err)
+			}
+			if got.String() != c.String() {
+				t.Errorf("ParseCSS(%q) = %v", c.String(), got)
+			}
+
+			got, err = ParseCSS(c.HexString())
+			if err != nil {
+				t.Fatalf("ParseCSS(%q) error = %v", c.HexString(), err)
+			}
+			if got.HexString() != c.HexString() {
+				t.Errorf("ParseCSS(%q) = %v", c.HexString(), got.HexString())
+			}
+		})
+	}
+}
+
+func TestParse(t *testing.T) {
+	nan := math.NaN()
+
+	tests := []struct {
+		name    string
+		s       string
+		want    color.Color
+		wantErr error
+	}{
+		{
+			name: "rgb",
+			s:    "rgb(255 191 128)",
+			want: CSS{R: 0xFF, G: 0xBF, B: 0x80, Opacity: 1.0},
+		},
+		{
+			name: "srgb",
+			s:    "color(srgb 1 0.5 0)",
+			want: SRGB{R: 1, G: 0.5, B: 0, A: 1},
+		},
+		{
+			name: "srgb_linear_alpha_none",
+			s:    "color(srgb-linear 1 0 0 / none)",
+			want: LinearSRGB{R: 1, G: 0, B: 0, A: nan},
+		},
+		{
+			name: "display_p3",
+			s:    "Color(Display-P3 100% 50% none / 0.5)",
+			want: DisplayP3{R: 1, G: 0.5, B: nan, A: 0.5},
+		},
+		{
+			name: "rec2020",
+			s:    "color(rec2020 0.1 0.2 0.3)",
+			want: Rec2020{R: 0.1, G: 0.2, B: 0.3, A: 1},
+		},
+		{
+			name: "xyz",
+			s:    "color(xyz 0.2 0.3 0.4)",
+			want: XYZ{X: 0.2, Y: 0.3, Z: 0.4, A: 1, White: D65},
+		},
+		{
+			name: "xyz_d50",
+			s:    "color(xyz-d50 20% 30% 40%)",
+			want: XYZ{X: 0.2, Y: 0.3, Z: 0.4, A: 1, White: D50},
+		},
+		{
+			name: "lab_percentage",
+			s:    "lab(50% 100% -50%)",
+			want: Lab{L: 50, A: 125, B: -62.5, Alpha: 1, White: D50},
+		},
+		{
+			name: "lab_clamped",
+			s:    "lab(150 0 0 / 2)",
+			want: Lab{L: 100, A: 0, B: 0, Alpha: 1, White: D50},
+		},
+		{
+			name: "lch",
+			s:    "LCH(50 -10 0.5turn)",
+			want: LCh{L: 50, C: 0, H: 180, Alpha: 1, White: D50},
+		},
+		{
+			name: "oklab",
+			s:    "oklab(0.5 0.1 -0.1 / 50%)",
+			want: OKLab{L: 0.5, A: 0.1, B: -0.1, Alpha: 0.5},
+		},
+		{
+			name: "oklch_none",
+			s:    "oklch(60% 50% none)",
+			want: OKLCh{L: 0.6, C: 0.2, H: nan, Alpha: 1},
+		},
+		{
+			name:    "unknown_space",
+			s:       "color(cmyk 0 0 0)",
+			wantErr: ErrUnknownSpace,
+		},
+		{
+			name:    "missing_space",
+			s:       "color(1 0 0)",
+			wantErr: ErrSyntax,
+		},
+		{
+			name:    "lab_legacy",
+			s:       "lab(50, 20, 30)",
+			wantErr: ErrSyntax,
+		},
+		{
+			name:    "lch_hue_percentage",
+			s:       "lch(50 20 30%)",
+			wantErr: ErrInvalidValue,
+		},
+		{
+			name:    "oklab_angle",
+			s:       "oklab(0.5 10deg 0)",
+			wantErr: ErrInvalidValue,
+		},
+		{
+			name:    "too_few",
+			s:       "color(srgb 1 0)",
+			wantErr: ErrSyntax,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := Parse(tt.s)
+			if !errors.Is(err, tt.wantErr) {
+				t.Errorf("Parse() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			// NaN does not equal itself, so the colors are compared by type and string.
+			if reflect.TypeOf(got) != reflect.TypeOf(tt.want) || fmt.Sprint(got) != fmt.Sprint(tt.want) {
+				t.Errorf("Parse() got = %#v, want %#v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestParse_serialize(t *testing.T) {
+	tests := []struct {
+		s    string
+		want string
+	}{
+		{s: "lab(50% 100% -50%)", want: "lab(50 125 -62.5)"},
+		{s: "LCH(50 20 none / 25%)", want: "lch(50 20 none / 0.25)"},
+		{s: "oklab(1 0 0 / none)", want: "oklab(1 0 0 / none)"},
+		{s: "oklch(60% 0.15 90deg)", want: "oklch(0.6 0.15 90)"},
+		{s: "color(xyz 0.2 0.3 0.4)", want: "color(xyz-d65 0.2 0.3 0.4)"},
+		{s: "color(XYZ-D50 1 1 1)", want: "color(xyz-d50 1 1 1)"},
+		{s: "color(display-p3 100% 50% none / 0.5)", want: "color(display-p3 1 0.5 none / 0.5)"},
+		{s: "color(prophoto-rgb 0.123456 0 1)", want: "color(prophoto-rgb 0.1235 0 1)"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.s, func(t *testing.T) {
+			c, err := Parse(tt.s)
+			if err != nil {
+				t.Fatalf("Parse() error = %v", err)
+			}
+
+			got := fmt.Sprint(c)
+			if got != tt.want {
+				t.Errorf("Parse(%q) = %s, want %s", tt.s, got, tt.want)
+			}
+
+			// The serialized form parses back to the same color.
+			c, err = Parse(got)
+			if err != nil {
+				t.Fatalf("Parse(%q) error = %v", got, err)
+			}
+			if fmt.Sprint(c) != got {
+				t.Errorf("Parse(%q) = %v", got, c)
+			}
+		})
+	}
+}
+
+func BenchmarkParseCSS(b *testing.B) {
+	for n := 0; n < b.N; n++ {
+		_, _ = ParseCSS("rgba(255,191,128,0.5)")
+	}
+}
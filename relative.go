@@ -0,0 +1,190 @@
+package colorx
+
+import (
+	"image/color"
+	"math"
+)
+
+// channelFunc returns the channel keywords of a color function and their values for the origin color of a relative
+// color, such as "r", "g", "b" and "alpha" for rgb().
+type channelFunc func(origin color.Color) map[string]float64
+
+var (
+	rgbChannels = scaledChannels(SpaceSRGB, [3]string{"r", "g", "b"},
+		[3]float64{math.MaxUint8, math.MaxUint8, math.MaxUint8})
+	hslChannels = scaledChannels(SpaceHSL, [3]string{"h", "s", "l"}, [3]float64{1, 100, 100})
+	hwbChannels = scaledChannels(SpaceHWB, [3]string{"h", "w", "b"}, [3]float64{1, 100, 100})
+)
+
+// relativeChannels returns the channelFunc with the keywords of the components of the space.
+func relativeChannels(space Space, k1, k2, k3 string) channelFunc {
+	return scaledChannels(space, [3]string{k1, k2, k3}, [3]float64{1, 1, 1})
+}
+
+// scaledChannels returns the channelFunc with the keywords of the components of the space, where each component is
+// multiplied by the matching scale. A missing or powerless component is zero.
+func scaledChannels(space Space, keywords [3]string, scale [3]float64) channelFunc {
+	return func(origin color.Color) map[string]float64 {
+		v, alpha := toSpace(origin, space)
+
+		channels := map[string]float64{"alpha": orZero(alpha)}
+		for i, k := range keywords {
+			channels[k] = orZero(v[i]) * scale[i]
+		}
+
+		return channels
+	}
+}
+
+// xyzChannels returns the channelFunc of the XYZ color spaces of the color() function, relative to the white point.
+func xyzChannels(white WhitePoint) channelFunc {
+	return func(origin color.Color) map[string]float64 {
+		xyz := convertXYZ(origin, white)
+
+		return map[string]float64{"x": orZero(xyz.X), "y": orZero(xyz.Y), "z": orZero(xyz.Z), "alpha": orZero(xyz.A)}
+	}
+}
+
+// parseOrigin parses the origin color of the relative color syntax, "from" followed by a color, at the start of the
+// arguments of a color function. It returns nil if the arguments do not start with "from".
+func (p *parser) parseOrigin() (color.Color, error) {
+	if t := p.peek(); t.kind != tokenIdent || t.text != "from" {
+		return nil, nil
+	}
+	p.next()
+
+	return p.parseColor()
+}
+
+// calc evaluates the calc() function t, whose opening parenthesis is part of t, to a number, a percentage or an
+// angle in degrees.
+func (p *parser) calc(t token) (token, error) {
+	v, err := p.calcSum()
+	if err != nil {
+		return token{}, err
+	}
+
+	if err := p.expect(tokenCloseParen); err != nil {
+		return token{}, err
+	}
+
+	v.pos = t.pos
+
+	return v, nil
+}
+
+// calcSum evaluates terms that are added or subtracted. Both terms must have the same type.
+func (p *parser) calcSum() (token, error) {
+	v, err := p.calcProduct()
+	if err != nil {
+		return token{}, err
+	}
+
+	for {
+		op := p.peek()
+		if op.kind != tokenDelim || op.text == "*" {
+			return v, nil
+		}
+		p.next()
+
+		w, err := p.calcProduct()
+		if err != nil {
+			return token{}, err
+		}
+
+		if w.kind != v.kind {
+			return token{}, p.error(op, ErrInvalidValue)
+		}
+
+		if op.text == "+" {
+			v.value += w.value
+		} else {
+			v.value -= w.value
+		}
+	}
+}
+
+// calcProduct evaluates factors that are multiplied or divided. One of the factors of a multiplication and the divisor
+// must be numbers.
+func (p *parser) calcProduct() (token, error) {
+	v, err := p.calcValue()
+	if err != nil {
+		return token{}, err
+	}
+
+	for {
+		op := p.peek()
+		if op.kind != tokenSlash && (op.kind != tokenDelim || op.text != "*") {
+			return v, nil
+		}
+		p.next()
+
+		w, err := p.calcValue()
+		if err != nil {
+			return token{}, err
+		}
+
+		switch {
+		case op.kind == tokenSlash && w.kind == tokenNumber && w.value != 0:
+			v.value /= w.value
+
+		case op.kind == tokenDelim && w.kind == tokenNumber:
+			v.value *= w.value
+
+		case op.kind == tokenDelim && v.kind == tokenNumber:
+			w.value *= v.value
+			v = w
+
+		default:
+			return token{}, p.error(op, ErrInvalidValue)
+		}
+	}
+}
+
+// calcValue evaluates a number, percentage, angle, channel keyword, constant, parenthesized sum or nested calc().
+func (p *parser) calcValue() (token, error) {
+	t := p.next()
+
+	switch t.kind {
+	case tokenNumber, tokenPercentage:
+		return t, nil
+
+	case tokenDimension:
+		deg, err := p.hue(t, false)
+		if err != nil {
+			return token{}, err
+		}
+		return token{kind: tokenDimension, text: "deg", value: deg, pos: t.pos}, nil
+
+	case tokenIdent:
+		if v, ok := p.channels[t.text]; ok {
+			return token{kind: tokenNumber, value: v, pos: t.pos}, nil
+		}
+
+		switch t.text {
+		case "pi":
+			return token{kind: tokenNumber, value: math.Pi, pos: t.pos}, nil
+
+		case "e":
+			return token{kind: tokenNumber, value: math.E, pos: t.pos}, nil
+		}
+		return token{}, p.error(t, ErrInvalidValue)
+
+	case tokenOpenParen:
+		v, err := p.calcSum()
+		if err != nil {
+			return token{}, err
+		}
+		return v, p.expect(tokenCloseParen)
+
+	case tokenFunction:
+		if t.text == "calc" {
+			return p.calc(t)
+		}
+		return token{}, p.error(t, ErrUnknownFunction)
+
+	case tokenEOF, tokenHash, tokenComma, tokenSlash, tokenCloseParen, tokenDelim:
+	}
+
+	return token{}, p.error(t, ErrSyntax)
+}
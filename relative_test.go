@@ -0,0 +1,258 @@
+package colorx
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+)
+
+func TestParse_relative(t *testing.T) {
+	tests := []struct {
+		name    string
+		s       string
+		want    string
+		wantErr error
+	}{
+		{
+			name: "rgb",
+			s:    "rgb(from #0af r g b / 50%)",
+			want: "rgba(0,170,255,0.5)",
+		},
+		{
+			name: "origin_alpha",
+			s:    "rgb(from rgb(10 20 30 / 0.4) r g b)",
+			want: "rgba(10,20,30,0.4)",
+		},
+		{
+			name: "rgb_calc",
+			s:    "rgb(from #ff8000 calc(r / 4) calc(g * 2) calc((b + 10) * 2))",
+			want: "rgb(64,255,20)",
+		},
+		{
+			name: "rgb_calc_half_below_max",
+			s:    "rgb(from red calc(r - 0.5) g b)",
+			want: "rgb(255,0,0)",
+		},
+		{
+			name: "rgb_calc_half",
+			s:    "rgb(from red calc(r / 2) g b)",
+			want: "rgb(128,0,0)",
+		},
+		{
+			name: "hsl_calc_half",
+			s:    "hsl(from white h s calc(l / 2))",
+			want: "rgb(128,128,128)",
+		},
+		{
+			name: "hsl_rotate",
+			s:    "hsl(from red calc(h + 120) s l)",
+			want: "rgb(0,255,0)",
+		},
+		{
+			name: "hwb",
+			s:    "hwb(from white h w calc(b + 50))",
+			want: "rgb(170,170,170)",
+		},
+		{
+			name: "lab",
+			s:    "lab(from lab(50 20 30) l calc(-1 * a) b / alpha)",
+			want: "lab(50 -20 30)",
+		},
+		{
+			name: "oklch",
+			s:    "oklch(from oklch(0.5 0.1 200) calc(l * 0.8) c h)",
+			want: "oklch(0.4 0.1 200)",
+		},
+		{
+			name: "color",
+			s:    "color(from color(display-p3 1 0 0) display-p3 r calc(g + 0.5) b)",
+			want: "color(display-p3 1 0.5 0)",
+		},
+		{
+			name: "color_xyz",
+			s:    "color(from color(xyz 0.2 0.3 0.4) xyz-d65 x calc(y * 2) z)",
+			want: "color(xyz-d65 0.2 0.6 0.4)",
+		},
+		{
+			name: "calc",
+			s:    "rgb(calc(255 / 2) 0 0)",
+			want: "rgb(128,0,0)",
+		},
+		{
+			name: "calc_angle",
+			s:    "hsl(calc(0.5turn + 60deg) 100% 50%)",
+			want: "rgb(0,0,255)",
+		},
+		{
+			name:    "legacy",
+			s:       "rgb(from red r, g, b)",
+			wantErr: ErrSyntax,
+		},
+		{
+			name:    "unknown_channel",
+			s:       "rgb(from red r g x)",
+			wantErr: ErrInvalidValue,
+		},
+		{
+			name:    "channel_without_origin",
+			s:       "rgb(calc(r) 0 0)",
+			wantErr: ErrInvalidValue,
+		},
+		{
+			name:    "calc_mixed_types",
+			s:       "rgb(from red calc(r + 10%) g b)",
+			wantErr: ErrInvalidValue,
+		},
+		{
+			name:    "calc_percentage_product",
+			s:       "rgb(calc(10% * 10%) 0 0)",
+			wantErr: ErrInvalidValue,
+		},
+		{
+			name:    "calc_division_by_zero",
+			s:       "rgb(from red calc(r / 0) g b)",
+			wantErr: ErrInvalidValue,
+		},
+		{
+			name:    "calc_unterminated",
+			s:       "rgb(from red calc(r + 1 g b)",
+			wantErr: ErrSyntax,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := Parse(tt.s)
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("Parse() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if err == nil && fmt.Sprint(got) != tt.want {
+				t.Errorf("Parse() got = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestParse_var(t *testing.T) {
+	vars := map[string]string{
+		"--brand":  "#0af",
+		"--Brand":  "red",
+		"--half":   "50%",
+		"--darker": "calc(l * 0.8)",
+		"--loop":   "var(--loop)",
+		"--bad":    "!",
+	}
+	resolver := WithVarResolver(func(name string) (string, bool) {
+		v, ok := vars[name]
+		return v, ok
+	})
+
+	tests := []struct {
+		name    string
+		s       string
+		opts    []ParseOption
+		want    string
+		wantErr error
+	}{
+		{
+			name: "origin",
+			s:    "rgb(from var(--brand) r g b / var(--half))",
+			opts: []ParseOption{resolver},
+			want: "rgba(0,170,255,0.5)",
+		},
+		{
+			name: "case_sensitive",
+			s:    "var(--Brand)",
+			opts: []ParseOption{resolver},
+			want: "rgb(255,0,0)",
+		},
+		{
+			name: "components",
+			s:    "oklch(from var(--brand) var(--darker) c h)",
+			opts: []ParseOption{resolver},
+			want: fmt.Sprint(mustParse(t, "oklch(from #0af calc(l * 0.8) c h)")),
+		},
+		{
+			name: "fallback",
+			s:    "var(--missing, rgb(0 0 255))",
+			opts: []ParseOption{resolver},
+			want: "rgb(0,0,255)",
+		},
+		{
+			name: "nested_fallback",
+			s:    "var(--missing, var(--brand))",
+			opts: []ParseOption{resolver},
+			want: "rgb(0,170,255)",
+		},
+		{
+			name: "no_resolver",
+			s:    "var(--brand, blue)",
+			want: "rgb(0,0,255)",
+		},
+		{
+			name:    "undefined",
+			s:       "var(--missing)",
+			opts:    []ParseOption{resolver},
+			wantErr: ErrInvalidVariable,
+		},
+		{
+			name:    "cycle",
+			s:       "var(--loop)",
+			opts:    []ParseOption{resolver},
+			wantErr: ErrInvalidVariable,
+		},
+		{
+			name:    "invalid_value",
+			s:       "var(--bad)",
+			opts:    []ParseOption{resolver},
+			wantErr: ErrSyntax,
+		},
+		{
+			name:    "not_custom_property",
+			s:       "var(brand)",
+			opts:    []ParseOption{resolver},
+			wantErr: ErrSyntax,
+		},
+		{
+			name:    "unterminated",
+			s:       "var(--missing, red",
+			opts:    []ParseOption{resolver},
+			wantErr: ErrUnexpectedEnd,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := Parse(tt.s, tt.opts...)
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("Parse() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if err == nil && fmt.Sprint(got) != tt.want {
+				t.Errorf("Parse() got = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestParse_varOffset(t *testing.T) {
+	_, err := Parse("rgb(var(--c) 0 0)", WithVarResolver(func(string) (string, bool) {
+		return "#fff", true
+	}))
+
+	var perr *ParseError
+	if !errors.As(err, &perr) {
+		t.Fatalf("Parse() error = %v, want %T", err, perr)
+	}
+	if perr.Offset != 4 {
+		t.Errorf("Parse() offset = %d, want 4", perr.Offset)
+	}
+}
+
+func mustParse(t *testing.T, s string) fmt.Stringer {
+	t.Helper()
+
+	c, err := Parse(s)
+	if err != nil {
+		t.Fatalf("Parse(%q) error = %v", s, err)
+	}
+
+	return c.(fmt.Stringer)
+}
@@ -0,0 +1,265 @@
+package colorx
+
+import (
+	"image/color"
+	"math"
+
+	"github.com/somebadcode/go-colorx/v2/internal/mathx"
+)
+
+// rgbSpace is an RGB color space, defined by the chromaticities of its primaries, its reference white and its transfer
+// function.
+type rgbSpace struct {
+	gamut
+
+	decode func(float64) float64 // Transfer function from encoded to linear-light channels
+	encode func(float64) float64 // Transfer function from linear-light to encoded channels
+}
+
+var (
+	displayP3 = newRGBSpace([3][2]float64{{0.680, 0.320}, {0.265, 0.690}, {0.150, 0.060}}, D65,
+		linearize, delinearize)
+
+	rec2020 = newRGBSpace([3][2]float64{{0.708, 0.292}, {0.170, 0.797}, {0.131, 0.046}}, D65,
+		rec2020Decode, rec2020Encode)
+
+	a98RGB = newRGBSpace([3][2]float64{{0.64, 0.33}, {0.21, 0.71}, {0.15, 0.06}}, D65,
+		a98RGBDecode, a98RGBEncode)
+
+	proPhotoRGB = newRGBSpace([3][2]float64{{0.734699, 0.265301}, {0.159597, 0.840403}, {0.036598, 0.000105}}, D50,
+		proPhotoRGBDecode, proPhotoRGBEncode)
+)
+
+// newRGBSpace returns the RGB color space with the primaries, as xy chromaticities of red, green and blue, the
+// reference white and the transfer functions.
+func newRGBSpace(primaries [3][2]float64, white WhitePoint, decode, encode func(float64) float64) rgbSpace {
+	var m mathx.Matrix3
+	for i, xy := range primaries {
+		m[0][i] = xy[0] / xy[1]
+		m[1][i] = 1.0
+		m[2][i] = (1.0 - xy[0] - xy[1]) / xy[1]
+	}
+
+	// Scale the primaries so that they add up to the reference white.
+	toXYZ := m.Mul(mathx.Diagonal(m.Inverse().MulVec(white.vector())))
+	fromLinearSRGB := toXYZ.Inverse().Mul(adaptation(D65, white)).Mul(linearSRGBToXYZ)
+
+	return rgbSpace{
+		gamut: gamut{
+			fromLinearSRGB: fromLinearSRGB,
+			toLinearSRGB:   fromLinearSRGB.Inverse(),
+		},
+		decode: decode,
+		encode: encode,
+	}
+}
+
+// linear converts encoded channels of the space to linear-light sRGB.
+func (s rgbSpace) linear(r, g, b, a float64) (float64, float64, float64, float64) {
+	rgb := s.toLinearSRGB.MulVec(mathx.Vector3{s.decode(orZero(r)), s.decode(orZero(g)), s.decode(orZero(b))})
+
+	return rgb[0], rgb[1], rgb[2], orZero(a)
+}
+
+// convert returns the color as encoded channels of the space with straight alpha.
+func (s rgbSpace) convert(c color.Color) (r, g, b, a float64) {
+	lr, lg, lb, a := toLinearSRGB(c)
+	rgb := s.rgb(lr, lg, lb)
+
+	return s.encode(rgb[0]), s.encode(rgb[1]), s.encode(rgb[2]), a
+}
+
+// DisplayP3 is an implementation of the Display P3 color model, with the primaries of DCI-P3, the D65 white point and
+// the transfer function of sRGB. Its gamut is about a quarter larger than that of sRGB, and most modern screens can
+// show it.
+type DisplayP3 struct {
+	R float64 // Red ∈ [0, 1]
+	G float64 // Green ∈ [0, 1]
+	B float64 // Blue ∈ [0, 1]
+	A float64 // Alpha ∈ [0, 1]
+}
+
+// DisplayP3Model can convert the color to the DisplayP3 color model defined in this package.
+var DisplayP3Model = color.ModelFunc(displayP3Model)
+
+func displayP3Model(c color.Color) color.Color {
+	if _, ok := c.(DisplayP3); ok {
+		return c
+	}
+
+	r, g, b, a := displayP3.convert(c)
+
+	return DisplayP3{R: r, G: g, B: b, A: a}
+}
+
+// RGBA returns the alpha-premultiplied red, green, blue and alpha values for the color, clipped to the sRGB gamut.
+func (c DisplayP3) RGBA() (r, g, b, a uint32) {
+	return premultiplyLinear(c.linear())
+}
+
+func (c DisplayP3) linear() (r, g, b, a float64) {
+	return displayP3.linear(c.R, c.G, c.B, c.A)
+}
+
+// String returns the color in the CSS color() function, such as "color(display-p3 1 0.5 0 / 0.8)".
+func (c DisplayP3) String() string {
+	return colorFunctionString(SpaceDisplayP3, c.R, c.G, c.B, c.A)
+}
+
+// Rec2020 is an implementation of the ITU-R BT.2020 color model for ultra high definition television, with the D65
+// white point. Its gamut covers most of the colors that people can see.
+type Rec2020 struct {
+	R float64 // Red ∈ [0, 1]
+	G float64 // Green ∈ [0, 1]
+	B float64 // Blue ∈ [0, 1]
+	A float64 // Alpha ∈ [0, 1]
+}
+
+// Rec2020Model can convert the color to the Rec2020 color model defined in this package.
+var Rec2020Model = color.ModelFunc(rec2020Model)
+
+func rec2020Model(c color.Color) color.Color {
+	if _, ok := c.(Rec2020); ok {
+		return c
+	}
+
+	r, g, b, a := rec2020.convert(c)
+
+	return Rec2020{R: r, G: g, B: b, A: a}
+}
+
+// RGBA returns the alpha-premultiplied red, green, blue and alpha values for the color, clipped to the sRGB gamut.
+func (c Rec2020) RGBA() (r, g, b, a uint32) {
+	return premultiplyLinear(c.linear())
+}
+
+func (c Rec2020) linear() (r, g, b, a float64) {
+	return rec2020.linear(c.R, c.G, c.B, c.A)
+}
+
+// String returns the color in the CSS color() function, such as "color(rec2020 1 0.5 0 / 0.8)".
+func (c Rec2020) String() string {
+	return colorFunctionString(SpaceRec2020, c.R, c.G, c.B, c.A)
+}
+
+// A98RGB is an implementation of the Adobe RGB (1998) color model, with the D65 white point. Its gamut is larger than
+// that of sRGB mostly in the greens and cyans.
+type A98RGB struct {
+	R float64 // Red ∈ [0, 1]
+	G float64 // Green ∈ [0, 1]
+	B float64 // Blue ∈ [0, 1]
+	A float64 // Alpha ∈ [0, 1]
+}
+
+// A98RGBModel can convert the color to the A98RGB color model defined in this package.
+var A98RGBModel = color.ModelFunc(a98RGBModel)
+
+func a98RGBModel(c color.Color) color.Color {
+	if _, ok := c.(A98RGB); ok {
+		return c
+	}
+
+	r, g, b, a := a98RGB.convert(c)
+
+	return A98RGB{R: r, G: g, B: b, A: a}
+}
+
+// RGBA returns the alpha-premultiplied red, green, blue and alpha values for the color, clipped to the sRGB gamut.
+func (c A98RGB) RGBA() (r, g, b, a uint32) {
+	return premultiplyLinear(c.linear())
+}
+
+func (c A98RGB) linear() (r, g, b, a float64) {
+	return a98RGB.linear(c.R, c.G, c.B, c.A)
+}
+
+// String returns the color in the CSS color() function, such as "color(a98-rgb 1 0.5 0 / 0.8)".
+func (c A98RGB) String() string {
+	return colorFunctionString(SpaceA98RGB, c.R, c.G, c.B, c.A)
+}
+
+// ProPhotoRGB is an implementation of the ProPhoto RGB (ROMM RGB) color model used for photo editing, with the D50
+// white point. Its gamut is very large, and some of its primaries are colors that people cannot see.
+type ProPhotoRGB struct {
+	R float64 // Red ∈ [0, 1]
+	G float64 // Green ∈ [0, 1]
+	B float64 // Blue ∈ [0, 1]
+	A float64 // Alpha ∈ [0, 1]
+}
+
+// ProPhotoRGBModel can convert the color to the ProPhotoRGB color model defined in this package.
+var ProPhotoRGBModel = color.ModelFunc(proPhotoRGBModel)
+
+func proPhotoRGBModel(c color.Color) color.Color {
+	if _, ok := c.(ProPhotoRGB); ok {
+		return c
+	}
+
+	r, g, b, a := proPhotoRGB.convert(c)
+
+	return ProPhotoRGB{R: r, G: g, B: b, A: a}
+}
+
+// RGBA returns the alpha-premultiplied red, green, blue and alpha values for the color, clipped to the sRGB gamut.
+func (c ProPhotoRGB) RGBA() (r, g, b, a uint32) {
+	return premultiplyLinear(c.linear())
+}
+
+func (c ProPhotoRGB) linear() (r, g, b, a float64) {
+	return proPhotoRGB.linear(c.R, c.G, c.B, c.A)
+}
+
+// String returns the color in the CSS color() function, such as "color(prophoto-rgb 1 0.5 0 / 0.8)".
+func (c ProPhotoRGB) String() string {
+	return colorFunctionString(SpaceProPhotoRGB, c.R, c.G, c.B, c.A)
+}
+
+// Constants of the Rec. 2020 transfer function.
+const (
+	rec2020Alpha = 1.09929682680944
+	rec2020Beta  = 0.018053968510807
+)
+
+func rec2020Decode(v float64) float64 {
+	abs := math.Abs(v)
+	if abs < rec2020Beta*4.5 {
+		return v / 4.5
+	}
+
+	return math.Copysign(math.Pow((abs+rec2020Alpha-1)/rec2020Alpha, 1/0.45), v)
+}
+
+func rec2020Encode(v float64) float64 {
+	abs := math.Abs(v)
+	if abs < rec2020Beta {
+		return v * 4.5
+	}
+
+	return math.Copysign(rec2020Alpha*math.Pow(abs, 0.45)-(rec2020Alpha-1), v)
+}
+
+func a98RGBDecode(v float64) float64 {
+	return math.Copysign(math.Pow(math.Abs(v), 563.0/256.0), v)
+}
+
+func a98RGBEncode(v float64) float64 {
+	return math.Copysign(math.Pow(math.Abs(v), 256.0/563.0), v)
+}
+
+func proPhotoRGBDecode(v float64) float64 {
+	abs := math.Abs(v)
+	if abs <= 16.0/512.0 {
+		return v / 16.0
+	}
+
+	return math.Copysign(math.Pow(abs, 1.8), v)
+}
+
+func proPhotoRGBEncode(v float64) float64 {
+	abs := math.Abs(v)
+	if abs < 1.0/512.0 {
+		return v * 16.0
+	}
+
+	return math.Copysign(math.Pow(abs, 1/1.8), v)
+}
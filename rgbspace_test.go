@@ -0,0 +1,158 @@
+package colorx
+
+import (
+	"fmt"
+	"image/color"
+	"testing"
+
+	"github.com/somebadcode/go-colorx/v2/internal/mathx"
+)
+
+func TestRGBSpaceModels(t *testing.T) {
+	red := color.RGBA{R: 0xFF, A: 0xFF}
+
+	tests := []struct {
+		name  string
+		model color.Model
+		c     color.Color
+		want  [4]float64
+	}{
+		{name: "display_p3", model: DisplayP3Model, c: red, want: [4]float64{0.91749, 0.20029, 0.13856, 1.0}},
+		{name: "rec2020", model: Rec2020Model, c: red, want: [4]float64{0.79198, 0.23098, 0.07376, 1.0}},
+		{name: "a98_rgb", model: A98RGBModel, c: red, want: [4]float64{0.85865, 0.0, 0.0, 1.0}},
+		{name: "prophoto_rgb", model: ProPhotoRGBModel, c: red, want: [4]float64{0.70225, 0.27572, 0.10355, 1.0}},
+		{name: "display_p3_white", model: DisplayP3Model, c: color.White, want: [4]float64{1.0, 1.0, 1.0, 1.0}},
+		{name: "prophoto_rgb_white", model: ProPhotoRGBModel, c: color.White, want: [4]float64{1.0, 1.0, 1.0, 1.0}},
+		{
+			name:  "rec2020_translucent",
+			model: Rec2020Model,
+			c:     color.NRGBA{G: 0xFF, A: 0x80},
+			want:  [4]float64{0.56754, 0.95928, 0.26897, 0x80 / 255.0},
+		},
+		{
+			name:  "linear_srgb",
+			model: LinearSRGBModel,
+			c:     DisplayP3{R: 1.0, A: 1.0},
+			want:  [4]float64{1.22494, -0.04206, -0.01965, 1.0},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := tt.model.Convert(tt.c)
+
+			var v [4]float64
+			switch c := got.(type) {
+			case DisplayP3:
+				v = [4]float64{c.R, c.G, c.B, c.A}
+			case Rec2020:
+				v = [4]float64{c.R, c.G, c.B, c.A}
+			case A98RGB:
+				v = [4]float64{c.R, c.G, c.B, c.A}
+			case ProPhotoRGB:
+				v = [4]float64{c.R, c.G, c.B, c.A}
+			case LinearSRGB:
+				v = [4]float64{c.R, c.G, c.B, c.A}
+			default:
+				t.Fatalf("Convert() = %T", got)
+			}
+
+			for i := range v {
+				if !mathx.EqualP(v[i], tt.want[i], 1e-4) {
+					t.Errorf("Convert() = %v, want %v", v, tt.want)
+					break
+				}
+			}
+		})
+	}
+}
+
+func TestRGBSpaceRoundTrip(t *testing.T) {
+	models := []color.Model{DisplayP3Model, Rec2020Model, A98RGBModel, ProPhotoRGBModel, LinearSRGBModel, SRGBModel}
+	colors := []color.RGBA64{
+		{R: 0x1234, G: 0x5678, B: 0x9ABC, A: 0xFFFF},
+		{R: 0x0001, G: 0x0002, B: 0x0003, A: 0xFFFF},
+		{R: 0x7FFF, G: 0x4000, A: 0x8000},
+	}
+	for _, m := range models {
+		for _, c := range colors {
+			gotR, gotG, gotB, gotA := m.Convert(c).RGBA()
+			if gotR != uint32(c.R) || gotG != uint32(c.G) || gotB != uint32(c.B) || gotA != uint32(c.A) {
+				t.Errorf("%T.RGBA() = %04x, %04x, %04x, %04x, want %v", m.Convert(c), gotR, gotG, gotB, gotA, c)
+			}
+		}
+	}
+}
+
+func TestRGBSpaceTransfer(t *testing.T) {
+	tests := []struct {
+		name   string
+		decode func(float64) float64
+		encode func(float64) float64
+	}{
+		{name: "rec2020", decode: rec2020Decode, encode: rec2020Encode},
+		{name: "a98_rgb", decode: a98RGBDecode, encode: a98RGBEncode},
+		{name: "prophoto_rgb", decode: proPhotoRGBDecode, encode: proPhotoRGBEncode},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			for _, v := range []float64{-0.5, 0, 0.001, 0.01, 0.1, 0.5, 1.0, 1.5} {
+				if got := tt.encode(tt.decode(v)); !mathx.Equal(got, v) {
+					t.Errorf("encode(decode(%v)) = %v", v, got)
+				}
+			}
+		})
+	}
+}
+
+func TestRGBSpace_String(t *testing.T) {
+	tests := []struct {
+		c    fmt.Stringer
+		want string
+	}{
+		{c: DisplayP3{R: 1.0, G: 0.5, A: 1.0}, want: "color(display-p3 1 0.5 0)"},
+		{c: DisplayP3{R: 0.123456, G: -0.00001, B: 1.2, A: 0.8}, want: "color(display-p3 0.1235 0 1.2 / 0.8)"},
+		{c: Rec2020{R: 0.25, G: 0.5, B: 0.75, A: 0.5}, want: "color(rec2020 0.25 0.5 0.75 / 0.5)"},
+		{c: A98RGB{G: 1.0, A: 1.0}, want: "color(a98-rgb 0 1 0)"},
+		{c: ProPhotoRGB{B: 1.0, A: 1.5}, want: "color(prophoto-rgb 0 0 1)"},
+		{c: SRGB{R: 1.0, A: 0.0}, want: "color(srgb 1 0 0 / 0)"},
+		{c: LinearSRGB{R: 0.5, G: 0.5, B: 0.5, A: 1.0}, want: "color(srgb-linear 0.5 0.5 0.5)"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.want, func(t *testing.T) {
+			if got := tt.c.String(); got != tt.want {
+				t.Errorf("String() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestRGBSpaceGamut(t *testing.T) {
+	p3Green := DisplayP3{G: 1.0, A: 1.0}
+
+	if InGamut(p3Green, SpaceSRGB) {
+		t.Errorf("InGamut(%v, SpaceSRGB) = true", p3Green)
+	}
+
+	for _, space := range []Space{SpaceDisplayP3, SpaceRec2020, SpaceProPhotoRGB} {
+		if !InGamut(p3Green, space) {
+			t.Errorf("InGamut(%v, %v) = false", p3Green, space)
+		}
+	}
+
+	// The red primary of Display P3 is just outside of the Rec. 2020 gamut.
+	if p3Red := (DisplayP3{R: 1.0, A: 1.0}); InGamut(p3Red, SpaceRec2020) {
+		t.Errorf("InGamut(%v, SpaceRec2020) = true", p3Red)
+	}
+
+	rec2020Green := Rec2020{G: 1.0, A: 1.0}
+
+	got := ToGamut(rec2020Green, SpaceDisplayP3, GamutCSS)
+	if _, ok := got.(DisplayP3); !ok || !InGamut(got, SpaceDisplayP3) {
+		t.Errorf("ToGamut(%v, SpaceDisplayP3) = %v", rec2020Green, got)
+	}
+
+	got = Mix(DisplayP3{R: 1.0, A: 1.0}, DisplayP3{B: 1.0, A: 1.0}, 0.5, InSpace(SpaceDisplayP3))
+	if fmt.Sprint(got) != "color(display-p3 0.5 0 0.5)" {
+		t.Errorf("Mix() = %v", got)
+	}
+}
@@ -0,0 +1,146 @@
+package colorx
+
+import (
+	"image/color"
+	"math"
+
+	"github.com/somebadcode/go-colorx/v2/internal/mathx"
+)
+
+// TailwindSteps are the steps of a Tailwind CSS color scale, from the lightest to the darkest.
+var TailwindSteps = []int{50, 100, 200, 300, 400, 500, 600, 700, 800, 900, 950}
+
+// The OKLCh lightness of the steps 0 and 1000 of a tonal scale. The steps in between are spread evenly.
+const (
+	scaleLightest = 0.99
+	scaleDarkest  = 0.19
+)
+
+// ScaleOption configures how TonalScale builds a scale.
+type ScaleOption func(*scaleOptions)
+
+type scaleOptions struct {
+	steps  []int
+	pin    int
+	pinned bool
+}
+
+// WithSteps makes TonalScale build a scale with the steps instead of TailwindSteps. Steps are numbers between 0 for
+// white and 1000 for black, so the steps of a 12 step scale could be 40, 120, …, 920. Steps outside of that range are
+// clamped.
+func WithSteps(steps ...int) ScaleOption {
+	return func(o *scaleOptions) {
+		o.steps = steps
+	}
+}
+
+// PinStep makes TonalScale put the color itself at the step, and spread the lighter and darker steps evenly on either
+// side of it. If the scale has no such step, the nearest step is used.
+func PinStep(step int) ScaleOption {
+	return func(o *scaleOptions) {
+		o.pin = step
+		o.pinned = true
+	}
+}
+
+// TonalScale returns a scale of tints and shades of the color, for use in a design system. The steps have perceptually
+// even lightness in OKLCh and the hue of the color. Their chroma is that of the color, tapered towards white and black
+// and reduced where needed to stay inside of the sRGB gamut. Alpha is kept.
+func TonalScale(c color.Color, opts ...ScaleOption) []CSS {
+	o := scaleOptions{steps: TailwindSteps}
+	for _, opt := range opts {
+		opt(&o)
+	}
+
+	steps := make([]int, len(o.steps))
+	for i, step := range o.steps {
+		steps[i] = int(mathx.Clamp(float64(step), 0, 1000))
+	}
+
+	base := oklchModel(c).(OKLCh)
+
+	pinned := -1
+	if o.pinned && len(steps) > 0 {
+		pinned = 0
+		for i, step := range steps {
+			if abs(step-o.pin) < abs(steps[pinned]-o.pin) {
+				pinned = i
+			}
+		}
+	}
+
+	scale := make([]CSS, len(steps))
+	for i, step := range steps {
+		if i == pinned {
+			scale[i] = cssFromFloat(toSRGB(base))
+			continue
+		}
+
+		lightness := scaleLightness(step)
+		if pinned >= 0 {
+			lightness = pinnedLightness(step, steps[pinned], base.L)
+		}
+
+		lch := OKLCh{L: lightness, C: base.C * chromaTaper(lightness, base.L), H: base.H, Alpha: base.Alpha}
+		scale[i] = cssFromFloat(toSRGB(maxSRGBChroma(lch)))
+	}
+
+	return scale
+}
+
+// scaleLightness returns the OKLCh lightness of a step of a tonal scale.
+func scaleLightness(step int) float64 {
+	return lerp(scaleLightest, scaleDarkest, float64(step)/1000.0)
+}
+
+// pinnedLightness returns the OKLCh lightness of a step of a tonal scale where the pinned step has the lightness l.
+func pinnedLightness(step, pin int, l float64) float64 {
+	switch {
+	case step < pin:
+		return lerp(scaleLightest, l, float64(step)/float64(pin))
+
+	case step == pin:
+		return l
+	}
+
+	return lerp(l, scaleDarkest, float64(step-pin)/float64(1000-pin))
+}
+
+// chromaTaper returns how much of the chroma of a color with lightness base to keep at the lightness l, so that the
+// chroma goes to zero towards white and black.
+func chromaTaper(l, base float64) float64 {
+	if base <= 0 || base >= 1 {
+		return 0
+	}
+
+	return math.Min(l*(1-l)/(base*(1-base)), 1)
+}
+
+// maxSRGBChroma returns the color with the chroma reduced, if needed, for it to be inside of the sRGB gamut.
+func maxSRGBChroma(lch OKLCh) OKLCh {
+	if srgbGamut.contains(srgbGamut.rgbOf(lch)) {
+		return lch
+	}
+
+	lo, hi := 0.0, lch.C
+	for hi-lo > 1e-5 {
+		lch.C = (lo + hi) / 2
+		if srgbGamut.contains(srgbGamut.rgbOf(lch)) {
+			lo = lch.C
+		} else {
+			hi = lch.C
+		}
+	}
+
+	lch.C = lo
+
+	return lch
+}
+
+func abs(x int) int {
+	if x < 0 {
+		return -x
+	}
+
+	return x
+}
@@ -0,0 +1,88 @@
+package colorx
+
+import (
+	"image/color"
+	"strings"
+	"testing"
+)
+
+func TestTonalScale(t *testing.T) {
+	blue := CSS{R: 0x3B, G: 0x82, B: 0xF6, Opacity: 1.0}
+
+	type args struct {
+		c    color.Color
+		opts []ScaleOption
+	}
+	tests := []struct {
+		name string
+		args args
+		want []string
+	}{
+		{
+			name: "tailwind",
+			args: args{c: blue},
+			want: []string{
+				"#E5EFFF", "#D1E3FF", "#A7C9FF", "#7DAEFF", "#5092FF", "#3177EA",
+				"#155ECF", "#0047AC", "#003482", "#00225A", "#001947",
+			},
+		},
+		{
+			name: "pinned",
+			args: args{c: blue, opts: []ScaleOption{PinStep(500)}},
+			want: []string{
+				"#E7F0FF", "#D4E5FF", "#AECDFF", "#87B5FF", "#5F9CFF", "#3B82F6",
+				"#1F66D8", "#004CB7", "#003789", "#00235D", "#001A49",
+			},
+		},
+		{
+			name: "pinned_nearest",
+			args: args{c: blue, opts: []ScaleOption{WithSteps(100, 300, 500, 700, 900), PinStep(480)}},
+			want: []string{"#D4E5FF", "#87B5FF", "#3B82F6", "#004CB7", "#00235D"},
+		},
+		{
+			name: "gray_12_steps",
+			args: args{
+				c:    CSS{R: 0x80, G: 0x80, B: 0x80, Opacity: 1.0},
+				opts: []ScaleOption{WithSteps(40, 120, 200, 280, 360, 440, 520, 600, 680, 760, 840, 920)},
+			},
+			want: []string{
+				"#F1F1F1", "#DCDCDC", "#C7C7C7", "#B3B3B3", "#9F9F9F", "#8B8B8B",
+				"#787878", "#666666", "#545454", "#434343", "#323232", "#222222",
+			},
+		},
+		{
+			name: "yellow_pinned_lightest",
+			args: args{c: CSS{R: 0xFF, G: 0xFF, Opacity: 1.0}, opts: []ScaleOption{WithSteps(0, 500, 1000), PinStep(0)}},
+			want: []string{"#FFFF00", "#7F7F00", "#151500"},
+		},
+		{
+			name: "no_steps",
+			args: args{c: blue, opts: []ScaleOption{WithSteps(), PinStep(500)}},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := TonalScale(tt.args.c, tt.args.opts...)
+			if len(got) != len(tt.want) {
+				t.Fatalf("TonalScale() returned %d colors, want %d", len(got), len(tt.want))
+			}
+
+			for i := range got {
+				if !strings.EqualFold(got[i].HexString(), tt.want[i]) {
+					t.Errorf("TonalScale()[%d] = %v, want %v", i, got[i].HexString(), tt.want[i])
+				}
+			}
+		})
+	}
+}
+
+func TestTonalScale_even(t *testing.T) {
+	scale := TonalScale(CSS{R: 0xE1, G: 0x1D, B: 0x48, Opacity: 1.0})
+
+	for i := 1; i < len(scale); i++ {
+		prev, next := convertOKLab(scale[i-1]), convertOKLab(scale[i])
+		if next.L >= prev.L {
+			t.Errorf("step %d is not darker than step %d: %v, %v", i, i-1, scale[i], scale[i-1])
+		}
+	}
+}
@@ -0,0 +1,189 @@
+package colorx
+
+import (
+	"strconv"
+	"strings"
+)
+
+// tokenKind is the kind of token produced when scanning CSS color text.
+type tokenKind int
+
+const (
+	tokenEOF        tokenKind = iota // End of input.
+	tokenIdent                       // Keyword such as "red" or "none".
+	tokenFunction                    // Function name including its opening parenthesis, e.g. "rgb(".
+	tokenHash                        // Hash such as "#FF8000".
+	tokenNumber                      // Unitless number.
+	tokenPercentage                  // Number followed by "%".
+	tokenDimension                   // Number followed by a unit, e.g. "90deg".
+	tokenComma                       // ",".
+	tokenSlash                       // "/".
+	tokenCloseParen                  // ")".
+	tokenOpenParen                   // "(" that does not belong to a function name.
+	tokenDelim                       // Arithmetic operator of calc(), "+", "-" or "*".
+)
+
+// token is a single lexical unit of CSS color text.
+type token struct {
+	kind  tokenKind
+	text  string  // Lowercase name of an ident, function or unit, the digits of a hash or the operator of a delim.
+	value float64 // Value of a number, percentage or dimension.
+	pos   int     // Byte offset of the token in the input.
+}
+
+// tokenize splits s into tokens, skipping whitespace. The last token is always tokenEOF.
+func tokenize(s string) ([]token, error) {
+	var tokens []token
+
+	pos := 0
+	for {
+		for pos < len(s) && isWhitespace(s[pos]) {
+			pos++
+		}
+
+		if pos >= len(s) {
+			return append(tokens, token{kind: tokenEOF, pos: pos}), nil
+		}
+
+		start := pos
+		c := s[pos]
+
+		switch {
+		case c == ',':
+			tokens = append(tokens, token{kind: tokenComma, pos: start})
+			pos++
+
+		case c == '/':
+			tokens = append(tokens, token{kind: tokenSlash, pos: start})
+			pos++
+
+		case c == ')':
+			tokens = append(tokens, token{kind: tokenCloseParen, pos: start})
+			pos++
+
+		case c == '(':
+			tokens = append(tokens, token{kind: tokenOpenParen, pos: start})
+			pos++
+
+		case c == '#':
+			pos = scanName(s, pos+1)
+			if pos == start+1 {
+				return nil, &ParseError{Input: s, Offset: start, Err: ErrInvalidHex}
+			}
+			tokens = append(tokens, token{kind: tokenHash, text: s[start+1 : pos], pos: start})
+
+		case startsNumber(s, pos):
+			end := scanNumber(s, pos)
+			value, err := strconv.ParseFloat(s[pos:end], 64)
+			if err != nil {
+				return nil, &ParseError{Input: s, Offset: start, Err: ErrSyntax}
+			}
+			pos = end
+
+			t := token{kind: tokenNumber, value: value, pos: start}
+			switch {
+			case pos < len(s) && s[pos] == '%':
+				t.kind = tokenPercentage
+				pos++
+
+			case pos < len(s) && isNameStart(s[pos]):
+				pos = scanName(s, end)
+				t.kind = tokenDimension
+				t.text = strings.ToLower(s[end:pos])
+			}
+			tokens = append(tokens, t)
+
+		case c == '+' || c == '*' || c == '-' && (pos+1 == len(s) || !isName(s[pos+1])):
+			tokens = append(tokens, token{kind: tokenDelim, text: s[start : start+1], pos: start})
+			pos++
+
+		case isNameStart(c):
+			pos = scanName(s, pos)
+			t := token{kind: tokenIdent, text: strings.ToLower(s[start:pos]), pos: start}
+			if strings.HasPrefix(s[start:pos], "--") {
+				// The names of custom properties are case-sensitive.
+				t.text = s[start:pos]
+			}
+			if pos < len(s) && s[pos] == '(' {
+				t.kind = tokenFunction
+				pos++
+			}
+			tokens = append(tokens, t)
+
+		default:
+			return nil, &ParseError{Input: s, Offset: start, Err: ErrSyntax}
+		}
+	}
+}
+
+func isWhitespace(c byte) bool {
+	return c == ' ' || c == '\t' || c == '\n' || c == '\r' || c == '\f'
+}
+
+func isDigit(c byte) bool {
+	return c >= '0' && c <= '9'
+}
+
+func isNameStart(c byte) bool {
+	return c >= 'a' && c <= 'z' || c >= 'A' && c <= 'Z' || c == '_' || c == '-' || c >= 0x80
+}
+
+func isName(c byte) bool {
+	return isNameStart(c) || isDigit(c)
+}
+
+// scanName returns the position of the first byte at or after pos that can not be part of a name.
+func scanName(s string, pos int) int {
+	for pos < len(s) && isName(s[pos]) {
+		pos++
+	}
+
+	return pos
+}
+
+// startsNumber reports whether a number begins at pos.
+func startsNumber(s string, pos int) bool {
+	if s[pos] == '+' || s[pos] == '-' {
+		pos++
+	}
+
+	if pos < len(s) && s[pos] == '.' {
+		pos++
+	}
+
+	return pos < len(s) && isDigit(s[pos])
+}
+
+// scanNumber returns the position right after the number that begins at pos.
+func scanNumber(s string, pos int) int {
+	if s[pos] == '+' || s[pos] == '-' {
+		pos++
+	}
+
+	for pos < len(s) && isDigit(s[pos]) {
+		pos++
+	}
+
+	if pos+1 < len(s) && s[pos] == '.' && isDigit(s[pos+1]) {
+		pos++
+		for pos < len(s) && isDigit(s[pos]) {
+			pos++
+		}
+	}
+
+	// An exponent is only part of the number if it has digits, otherwise the "e" starts a unit.
+	if pos < len(s) && (s[pos] == 'e' || s[pos] == 'E') {
+		exp := pos + 1
+		if exp < len(s) && (s[exp] == '+' || s[exp] == '-') {
+			exp++
+		}
+		if exp < len(s) && isDigit(s[exp]) {
+			pos = exp
+			for pos < len(s) && isDigit(s[pos]) {
+				pos++
+			}
+		}
+	}
+
+	return pos
+}
@@ -0,0 +1,296 @@
+package colorx
+
+import (
+	"image/color"
+	"math"
+	"strconv"
+
+	"github.com/somebadcode/go-colorx/v2/internal/mathx"
+)
+
+// Space is a color space that colors can be interpolated in. The names of the spaces are those used by CSS.
+type Space int
+
+const (
+	SpaceSRGB        Space = iota // sRGB, as SRGB
+	SpaceLinearSRGB               // Linear-light sRGB, as LinearSRGB
+	SpaceHSL                      // HSL, as HSLA
+	SpaceHWB                      // HWB, as HWBA
+	SpaceLab                      // CIELAB relative to D50, as Lab
+	SpaceLCh                      // CIE LCh relative to D50, as LCh
+	SpaceOKLab                    // OKLab, as OKLab
+	SpaceOKLCh                    // OKLCh, as OKLCh
+	SpaceDisplayP3                // Display P3, as DisplayP3
+	SpaceRec2020                  // Rec. 2020, as Rec2020
+	SpaceA98RGB                   // Adobe RGB (1998), as A98RGB
+	SpaceProPhotoRGB              // ProPhoto RGB, as ProPhotoRGB
+	SpaceHSV                      // HSV, as HSVA. It is not a CSS color space.
+)
+
+// String returns the CSS name of the color space.
+func (s Space) String() string {
+	switch s {
+	case SpaceSRGB:
+		return "srgb"
+
+	case SpaceLinearSRGB:
+		return "srgb-linear"
+
+	case SpaceHSL:
+		return "hsl"
+
+	case SpaceHWB:
+		return "hwb"
+
+	case SpaceLab:
+		return "lab"
+
+	case SpaceLCh:
+		return "lch"
+
+	case SpaceOKLab:
+		return "oklab"
+
+	case SpaceOKLCh:
+		return "oklch"
+
+	case SpaceDisplayP3:
+		return "display-p3"
+
+	case SpaceRec2020:
+		return "rec2020"
+
+	case SpaceA98RGB:
+		return "a98-rgb"
+
+	case SpaceProPhotoRGB:
+		return "prophoto-rgb"
+
+	case SpaceHSV:
+		return "hsv"
+
+	default:
+		return "Space(" + strconv.Itoa(int(s)) + ")"
+	}
+}
+
+// Chroma below which the hue of a color in the LCh and OKLCh spaces is powerless. The thresholds are well above the
+// rounding errors of converting a gray.
+const (
+	lchAchromatic   = 1e-4
+	oklchAchromatic = 1e-6
+)
+
+// hueIndex returns the index of the hue component of the space, or -1 if the space has no hue.
+func (s Space) hueIndex() int {
+	switch s {
+	case SpaceHSL, SpaceHSV, SpaceHWB:
+		return 0
+
+	case SpaceLCh, SpaceOKLCh:
+		return 2
+
+	default:
+		return -1
+	}
+}
+
+// powerless reports whether the hue of the components in the space is powerless, because the color is achromatic.
+func (s Space) powerless(v [3]float64) bool {
+	switch s {
+	case SpaceHSL:
+		return mathx.Equal(v[1], 0)
+
+	case SpaceHSV:
+		return mathx.Equal(v[1], 0) || mathx.Equal(v[2], 0)
+
+	case SpaceHWB:
+		return v[1]+v[2] >= 1.0-mathx.DefaultPrecision
+
+	case SpaceLCh:
+		return v[1] < lchAchromatic
+
+	case SpaceOKLCh:
+		return v[1] < oklchAchromatic
+
+	default:
+		return false
+	}
+}
+
+// toSpace returns the components of the color in the space and its straight alpha. A hue that is powerless, because the
+// color is achromatic, is returned as NaN. A color that already has the type of the space keeps its missing components.
+func toSpace(c color.Color, space Space) (v [3]float64, alpha float64) {
+	v, alpha, ok := components(c, space)
+	if !ok {
+		v, alpha = convertComponents(c, space)
+	}
+
+	if i := space.hueIndex(); i >= 0 {
+		if space.powerless(v) {
+			v[i] = math.NaN()
+		} else {
+			v[i] = normalizeHue(v[i])
+		}
+	}
+
+	return v, alpha
+}
+
+// components returns the components of the color and its straight alpha, and false if the color does not have the type
+// of the space.
+func components(c color.Color, space Space) ([3]float64, float64, bool) {
+	switch c := c.(type) {
+	case SRGB:
+		return [3]float64{c.R, c.G, c.B}, c.A, space == SpaceSRGB
+
+	case LinearSRGB:
+		return [3]float64{c.R, c.G, c.B}, c.A, space == SpaceLinearSRGB
+
+	case HSLA:
+		return [3]float64{c.H, c.S, c.L}, c.A, space == SpaceHSL
+
+	case HSVA:
+		return [3]float64{c.H, c.S, c.V}, c.A, space == SpaceHSV
+
+	case HWBA:
+		return [3]float64{c.H, c.W, c.B}, c.A, space == SpaceHWB
+
+	case Lab:
+		return [3]float64{c.L, c.A, c.B}, c.Alpha, space == SpaceLab && c.White == D50
+
+	case LCh:
+		return [3]float64{c.L, c.C, c.H}, c.Alpha, space == SpaceLCh && c.White == D50
+
+	case OKLab:
+		return [3]float64{c.L, c.A, c.B}, c.Alpha, space == SpaceOKLab
+
+	case OKLCh:
+		return [3]float64{c.L, c.C, c.H}, c.Alpha, space == SpaceOKLCh
+
+	case DisplayP3:
+		return [3]float64{c.R, c.G, c.B}, c.A, space == SpaceDisplayP3
+
+	case Rec2020:
+		return [3]float64{c.R, c.G, c.B}, c.A, space == SpaceRec2020
+
+	case A98RGB:
+		return [3]float64{c.R, c.G, c.B}, c.A, space == SpaceA98RGB
+
+	case ProPhotoRGB:
+		return [3]float64{c.R, c.G, c.B}, c.A, space == SpaceProPhotoRGB
+	}
+
+	return [3]float64{}, 0, false
+}
+
+// convertComponents converts the color to the space and returns its components and straight alpha.
+func convertComponents(c color.Color, space Space) (v [3]float64, alpha float64) {
+	switch space {
+	case SpaceSRGB:
+		v[0], v[1], v[2], alpha = toSRGB(c)
+
+	case SpaceLinearSRGB:
+		v[0], v[1], v[2], alpha = toLinearSRGB(c)
+
+	case SpaceHSL:
+		r, g, b, a := toSRGB(c)
+		v[0], v[1], v[2] = rgbToHSL(r, g, b)
+		alpha = a
+
+	case SpaceHSV:
+		r, g, b, a := toSRGB(c)
+		v[0], v[1], v[2] = rgbToHSV(r, g, b)
+		alpha = a
+
+	case SpaceHWB:
+		r, g, b, a := toSRGB(c)
+		v[0], v[1], v[2] = rgbToHWB(r, g, b)
+		alpha = a
+
+	case SpaceDisplayP3:
+		v[0], v[1], v[2], alpha = displayP3.convert(c)
+
+	case SpaceRec2020:
+		v[0], v[1], v[2], alpha = rec2020.convert(c)
+
+	case SpaceA98RGB:
+		v[0], v[1], v[2], alpha = a98RGB.convert(c)
+
+	case SpaceProPhotoRGB:
+		v[0], v[1], v[2], alpha = proPhotoRGB.convert(c)
+
+	case SpaceLab:
+		lab := convertLab(c, D50)
+		v, alpha = [3]float64{lab.L, lab.A, lab.B}, lab.Alpha
+
+	case SpaceLCh:
+		lch := convertLCh(c, D50)
+		v, alpha = [3]float64{lch.L, lch.C, lch.H}, lch.Alpha
+
+	case SpaceOKLCh:
+		lch := okLChFromOKLab(convertOKLab(c))
+		v, alpha = [3]float64{lch.L, lch.C, lch.H}, lch.Alpha
+
+	default: // SpaceOKLab
+		lab := convertOKLab(c)
+		v, alpha = [3]float64{lab.L, lab.A, lab.B}, lab.Alpha
+	}
+
+	return v, alpha
+}
+
+// fromSpace returns the color with the components in the space and straight alpha, as the type of the space.
+func fromSpace(space Space, v [3]float64, alpha float64) color.Color {
+	switch space {
+	case SpaceSRGB:
+		return SRGB{R: v[0], G: v[1], B: v[2], A: alpha}
+
+	case SpaceLinearSRGB:
+		return LinearSRGB{R: v[0], G: v[1], B: v[2], A: alpha}
+
+	case SpaceHSL:
+		return HSLA{H: v[0], S: v[1], L: v[2], A: alpha}
+
+	case SpaceHSV:
+		return HSVA{H: v[0], S: v[1], V: v[2], A: alpha}
+
+	case SpaceHWB:
+		return HWBA{H: v[0], W: v[1], B: v[2], A: alpha}
+
+	case SpaceDisplayP3:
+		return DisplayP3{R: v[0], G: v[1], B: v[2], A: alpha}
+
+	case SpaceRec2020:
+		return Rec2020{R: v[0], G: v[1], B: v[2], A: alpha}
+
+	case SpaceA98RGB:
+		return A98RGB{R: v[0], G: v[1], B: v[2], A: alpha}
+
+	case SpaceProPhotoRGB:
+		return ProPhotoRGB{R: v[0], G: v[1], B: v[2], A: alpha}
+
+	case SpaceLab:
+		return Lab{L: v[0], A: v[1], B: v[2], Alpha: alpha, White: D50}
+
+	case SpaceLCh:
+		return LCh{L: v[0], C: v[1], H: v[2], Alpha: alpha, White: D50}
+
+	case SpaceOKLCh:
+		return OKLCh{L: v[0], C: v[1], H: v[2], Alpha: alpha}
+
+	default: // SpaceOKLab
+		return OKLab{L: v[0], A: v[1], B: v[2], Alpha: alpha}
+	}
+}
+
+// convertSpace returns the color as the type of the space. A powerless hue is zero.
+func convertSpace(c color.Color, space Space) color.Color {
+	v, alpha := toSpace(c, space)
+
+	if i := space.hueIndex(); i >= 0 && math.IsNaN(v[i]) {
+		v[i] = 0
+	}
+
+	return fromSpace(space, v, alpha)
+}
@@ -0,0 +1,104 @@
+package colorx
+
+import (
+	"database/sql/driver"
+	"errors"
+	"fmt"
+	"image/color"
+	"math"
+)
+
+// ErrNull is returned by Scan for a SQL NULL, since a color has no value that means "no color". For a nullable column,
+// scan into a pointer to a pointer, such as a **CSS, which database/sql sets to nil for a NULL.
+var ErrNull = errors.New("cannot scan NULL")
+
+// Value stores the color as its canonical hexadecimal notation, "#rrggbb" or "#rrggbbaa" if it is translucent.
+func (c CSS) Value() (driver.Value, error) {
+	return c.HexString(), nil
+}
+
+// Scan reads a color stored as text in any notation that ParseCSS understands, as a []byte of such text or as an
+// integer packed as 0xRRGGBBAA. A NULL is an ErrNull error.
+func (c *CSS) Scan(src interface{}) error {
+	v, err := scanColor(src, c)
+	if err != nil {
+		return err
+	}
+
+	*c = cssModel(v).(CSS)
+
+	return nil
+}
+
+// Value stores the color as the canonical hexadecimal notation of CSS, which rounds the channels to 8 bits.
+func (hsla HSLA) Value() (driver.Value, error) {
+	return cssModel(hsla).(CSS).HexString(), nil
+}
+
+// Scan reads a color stored as text in any notation that Parse understands, as a []byte of such text or as an integer
+// packed as 0xRRGGBBAA. A NULL is an ErrNull error.
+func (hsla *HSLA) Scan(src interface{}) error {
+	v, err := scanColor(src, hsla)
+	if err != nil {
+		return err
+	}
+
+	*hsla = hslaModel(v).(HSLA)
+
+	return nil
+}
+
+// Value stores the color as the canonical hexadecimal notation of CSS, which rounds the channels to 8 bits.
+func (hsva HSVA) Value() (driver.Value, error) {
+	return cssModel(hsva).(CSS).HexString(), nil
+}
+
+// Scan reads a color stored as text in any notation that Parse understands, as a []byte of such text or as an integer
+// packed as 0xRRGGBBAA. A NULL is an ErrNull error.
+func (hsva *HSVA) Scan(src interface{}) error {
+	v, err := scanColor(src, hsva)
+	if err != nil {
+		return err
+	}
+
+	if hsla, ok := v.(HSLA); ok {
+		*hsva = hsla.hsva()
+	} else {
+		*hsva = hsvaModel(v).(HSVA)
+	}
+
+	return nil
+}
+
+// scanColor returns the color stored in src, which is text, a []byte of text or a packed 0xRRGGBBAA integer. dst is
+// the destination of Scan, for the error message. A NULL is an error that wraps ErrNull.
+func scanColor(src, dst interface{}) (color.Color, error) {
+	switch src := src.(type) {
+	case string:
+		return unmarshalColor([]byte(src))
+
+	case []byte:
+		return unmarshalColor(src)
+
+	case nil:
+		return nil, fmt.Errorf("colorx: %w into %T, scan into a pointer to it for a nullable column", ErrNull, dst)
+
+	case int64:
+		if src < 0 || src > math.MaxUint32 {
+			return nil, fmt.Errorf("colorx: cannot scan %#x into %T: out of range", src, dst)
+		}
+		return cssFromPacked(uint32(src)), nil
+	}
+
+	return nil, fmt.Errorf("colorx: cannot scan %T into %T", src, dst)
+}
+
+// cssFromPacked returns the color of an integer packed as 0xRRGGBBAA.
+func cssFromPacked(v uint32) CSS {
+	return CSS{
+		R:       uint8(v >> 24),
+		G:       uint8(v >> 16),
+		B:       uint8(v >> 8),
+		Opacity: float64(uint8(v)) / math.MaxUint8,
+	}
+}
@@ -0,0 +1,256 @@
+package colorx
+
+import (
+	"database/sql"
+	"database/sql/driver"
+	"errors"
+	"io"
+	"math"
+	"sync"
+	"testing"
+
+	"github.com/somebadcode/go-colorx/v2/internal/mathx"
+)
+
+// fakeDriver is an in-memory database/sql driver with a single column. Every statement that is executed appends its
+// argument to the column and every query returns all of it.
+type fakeDriver struct {
+	mu     sync.Mutex
+	values []driver.Value
+}
+
+func (d *fakeDriver) Open(string) (driver.Conn, error) {
+	return fakeConn{d}, nil
+}
+
+type fakeConn struct {
+	d *fakeDriver
+}
+
+func (c fakeConn) Prepare(string) (driver.Stmt, error) {
+	return fakeStmt(c), nil
+}
+
+func (c fakeConn) Close() error {
+	return nil
+}
+
+func (c fakeConn) Begin() (driver.Tx, error) {
+	return nil, errors.New("transactions are not supported")
+}
+
+type fakeStmt struct {
+	d *fakeDriver
+}
+
+func (s fakeStmt) Close() error {
+	return nil
+}
+
+func (s fakeStmt) NumInput() int {
+	return -1
+}
+
+func (s fakeStmt) Exec(args []driver.Value) (driver.Result, error) {
+	s.d.mu.Lock()
+	defer s.d.mu.Unlock()
+
+	s.d.values = append(s.d.values, args...)
+
+	return driver.RowsAffected(len(args)), nil
+}
+
+func (s fakeStmt) Query([]driver.Value) (driver.Rows, error) {
+	s.d.mu.Lock()
+	defer s.d.mu.Unlock()
+
+	return &fakeRows{values: append([]driver.Value(nil), s.d.values...)}, nil
+}
+
+type fakeRows struct {
+	values []driver.Value
+}
+
+func (r *fakeRows) Columns() []string {
+	return []string{"color"}
+}
+
+func (r *fakeRows) Close() error {
+	return nil
+}
+
+func (r *fakeRows) Next(dest []driver.Value) error {
+	if len(r.values) == 0 {
+		return io.EOF
+	}
+
+	dest[0], r.values = r.values[0], r.values[1:]
+
+	return nil
+}
+
+var (
+	fakeDB     = &fakeDriver{}
+	registerDB sync.Once
+)
+
+// openFakeDB returns a database whose column is empty.
+func openFakeDB(t *testing.T) *sql.DB {
+	t.Helper()
+
+	registerDB.Do(func() {
+		sql.Register("colorx-fake", fakeDB)
+	})
+
+	fakeDB.mu.Lock()
+	fakeDB.values = nil
+	fakeDB.mu.Unlock()
+
+	db, err := sql.Open("colorx-fake", "")
+	if err != nil {
+		t.Fatalf("Open() error = %v", err)
+	}
+	t.Cleanup(func() {
+		_ = db.Close()
+	})
+
+	return db
+}
+
+func TestSQL_roundTrip(t *testing.T) {
+	db := openFakeDB(t)
+
+	css := CSS{R: 0xFF, G: 0x88, Opacity: 0.5}
+	hsla := HSLA{H: 120.0, S: 1.0, L: 0.25, A: 1.0}
+	hsva := HSVA{H: 240.0, S: 1.0, V: 0.8, A: 1.0}
+
+	for _, v := range []interface{}{css, hsla, hsva, "hsl(30 100% 50%)", int64(0x336699FF), nil, nil} {
+		if _, err := db.Exec("INSERT INTO colors VALUES (?)", v); err != nil {
+			t.Fatalf("Exec(%v) error = %v", v, err)
+		}
+	}
+
+	fakeDB.mu.Lock()
+	stored := append([]driver.Value(nil), fakeDB.values...)
+	fakeDB.mu.Unlock()
+
+	for i, want := range []driver.Value{"#ff880080", "#008000", "#0000cc"} {
+		if stored[i] != want {
+			t.Errorf("stored value %d = %v, want %v", i, stored[i], want)
+		}
+	}
+
+	rows, err := db.Query("SELECT color FROM colors")
+	if err != nil {
+		t.Fatalf("Query() error = %v", err)
+	}
+	defer rows.Close()
+
+	var (
+		gotCSS  CSS
+		gotHSLA HSLA
+		gotHSVA HSVA
+		gotText CSS
+		gotInt  CSS
+		gotNull = &CSS{}
+	)
+	for _, dst := range []interface{}{&gotCSS, &gotHSLA, &gotHSVA, &gotText, &gotInt, &gotNull} {
+		if !rows.Next() {
+			t.Fatalf("Next() = false, err = %v", rows.Err())
+		}
+		if err := rows.Scan(dst); err != nil {
+			t.Fatalf("Scan() error = %v", err)
+		}
+	}
+
+	// A NULL can only be scanned into a pointer.
+	var notNull CSS
+	if !rows.Next() {
+		t.Fatalf("Next() = false, err = %v", rows.Err())
+	}
+	if err := rows.Scan(&notNull); !errors.Is(err, ErrNull) {
+		t.Errorf("Scan(NULL) error = %v, want %v", err, ErrNull)
+	}
+
+	if gotCSS != (CSS{R: 0xFF, G: 0x88, Opacity: float64(0x80) / 0xFF}) {
+		t.Errorf("Scan(CSS) got = %v, want %v", gotCSS, css)
+	}
+	if !mathx.Equal(gotHSLA.H, hsla.H) || !mathx.Equal(gotHSLA.S, hsla.S) ||
+		!mathx.EqualP(gotHSLA.L, hsla.L, 0.5/0xFF) || !mathx.Equal(gotHSLA.A, hsla.A) {
+		t.Errorf("Scan(HSLA) got = %v, want %v", gotHSLA, hsla)
+	}
+	if !mathx.Equal(gotHSVA.H, hsva.H) || !mathx.Equal(gotHSVA.S, hsva.S) ||
+		!mathx.Equal(gotHSVA.V, hsva.V) || !mathx.Equal(gotHSVA.A, hsva.A) {
+		t.Errorf("Scan(HSVA) got = %#v, want %#v", gotHSVA, hsva)
+	}
+	if gotText != (CSS{R: 0xFF, G: 0x80, Opacity: 1.0}) {
+		t.Errorf("Scan(text) got = %v, want rgb(255,128,0)", gotText)
+	}
+	if gotInt != (CSS{R: 0x33, G: 0x66, B: 0x99, Opacity: 1.0}) {
+		t.Errorf("Scan(int64) got = %v, want rgb(51,102,153)", gotInt)
+	}
+	if gotNull != nil {
+		t.Errorf("Scan(NULL) got = %v, want nil", gotNull)
+	}
+}
+
+func TestCSS_Scan(t *testing.T) {
+	tests := []struct {
+		name    string
+		src     interface{}
+		want    CSS
+		wantErr bool
+	}{
+		{
+			name: "string",
+			src:  "#ff8800",
+			want: CSS{R: 0xFF, G: 0x88, Opacity: 1.0},
+		},
+		{
+			name: "bytes",
+			src:  []byte("rgb(0 0 255 / 0)"),
+			want: CSS{B: 0xFF},
+		},
+		{
+			name: "packed",
+			src:  int64(0xFF880080),
+			want: CSS{R: 0xFF, G: 0x88, Opacity: float64(0x80) / 0xFF},
+		},
+		{
+			name:    "packed_out_of_range",
+			src:     int64(math.MaxUint32 + 1),
+			wantErr: true,
+		},
+		{
+			name:    "packed_negative",
+			src:     int64(-1),
+			wantErr: true,
+		},
+		{
+			name:    "invalid_text",
+			src:     "#ff88zz",
+			wantErr: true,
+		},
+		{
+			name:    "null",
+			src:     nil,
+			wantErr: true,
+		},
+		{
+			name:    "float",
+			src:     1.5,
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var got CSS
+			if err := got.Scan(tt.src); (err != nil) != tt.wantErr {
+				t.Fatalf("Scan() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if got != tt.want {
+				t.Errorf("Scan() got = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
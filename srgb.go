@@ -0,0 +1,203 @@
+package colorx
+
+import (
+	"image/color"
+	"math"
+
+	"github.com/somebadcode/go-colorx/v2/internal/mathx"
+)
+
+// linearer is implemented by the color models in this package that are not bound to the sRGB gamut, so that they can
+// be converted to each other without being clipped or rounded on the way.
+type linearer interface {
+	// linear returns the color as unclipped linear-light sRGB with straight alpha.
+	linear() (r, g, b, a float64)
+}
+
+// srgber is implemented by the color models in this package that are stored as sRGB, so that their channels can be
+// read without a round trip through linear light, which would make an exact 255 or 0.5 inexact.
+type srgber interface {
+	// srgb returns the color as unclipped sRGB with straight alpha.
+	srgb() (r, g, b, a float64)
+}
+
+// SRGB is an implementation of the sRGB color model with floating point channels. Unlike color.RGBA64 the channels are
+// not alpha-premultiplied and they are not clipped, so SRGB can hold colors outside of the sRGB gamut.
+type SRGB struct {
+	R float64 // Red ∈ [0, 1] in the sRGB gamut
+	G float64 // Green ∈ [0, 1] in the sRGB gamut
+	B float64 // Blue ∈ [0, 1] in the sRGB gamut
+	A float64 // Alpha ∈ [0, 1]
+}
+
+// SRGBModel can convert the color to the SRGB color model defined in this package.
+var SRGBModel = color.ModelFunc(srgbModel)
+
+func srgbModel(c color.Color) color.Color {
+	if _, ok := c.(SRGB); ok {
+		return c
+	}
+
+	r, g, b, a := toSRGB(c)
+
+	return SRGB{R: r, G: g, B: b, A: a}
+}
+
+// RGBA returns the alpha-premultiplied red, green, blue and alpha values for the color.
+func (c SRGB) RGBA() (r, g, b, a uint32) {
+	return premultiply(c.R, c.G, c.B, c.A)
+}
+
+func (c SRGB) linear() (r, g, b, a float64) {
+	return linearizeRGB(c.srgb())
+}
+
+func (c SRGB) srgb() (r, g, b, a float64) {
+	return orZero(c.R), orZero(c.G), orZero(c.B), orZero(c.A)
+}
+
+// String returns the color in the CSS color() function, such as "color(srgb 1 0.5 0 / 0.8)".
+func (c SRGB) String() string {
+	return colorFunctionString(SpaceSRGB, c.R, c.G, c.B, c.A)
+}
+
+// LinearSRGB is an implementation of the linear-light sRGB color model, sRGB without the transfer function. The
+// channels are proportional to the amount of light, which makes it the model to blend light in.
+type LinearSRGB struct {
+	R float64 // Red ∈ [0, 1] in the sRGB gamut
+	G float64 // Green ∈ [0, 1] in the sRGB gamut
+	B float64 // Blue ∈ [0, 1] in the sRGB gamut
+	A float64 // Alpha ∈ [0, 1]
+}
+
+// LinearSRGBModel can convert the color to the LinearSRGB color model defined in this package.
+var LinearSRGBModel = color.ModelFunc(linearSRGBModel)
+
+func linearSRGBModel(c color.Color) color.Color {
+	if _, ok := c.(LinearSRGB); ok {
+		return c
+	}
+
+	r, g, b, a := toLinearSRGB(c)
+
+	return LinearSRGB{R: r, G: g, B: b, A: a}
+}
+
+// RGBA returns the alpha-premultiplied red, green, blue and alpha values for the color.
+func (c LinearSRGB) RGBA() (r, g, b, a uint32) {
+	return premultiplyLinear(c.linear())
+}
+
+func (c LinearSRGB) linear() (r, g, b, a float64) {
+	return orZero(c.R), orZero(c.G), orZero(c.B), orZero(c.A)
+}
+
+// String returns the color in the CSS color() function, such as "color(srgb-linear 1 0.5 0 / 0.8)".
+func (c LinearSRGB) String() string {
+	return colorFunctionString(SpaceLinearSRGB, c.R, c.G, c.B, c.A)
+}
+
+// linearSRGBToXYZ converts linear-light sRGB to CIE XYZ relative to D65.
+var linearSRGBToXYZ = mathx.Matrix3{
+	{506752.0 / 1228815.0, 87881.0 / 245763.0, 12673.0 / 70218.0},
+	{87098.0 / 409605.0, 175762.0 / 245763.0, 12673.0 / 175545.0},
+	{7918.0 / 409605.0, 87881.0 / 737289.0, 1001167.0 / 1053270.0},
+}
+
+// xyzToLinearSRGB converts CIE XYZ relative to D65 to linear-light sRGB.
+var xyzToLinearSRGB = linearSRGBToXYZ.Inverse()
+
+// toLinearSRGB returns the color as linear-light sRGB with straight alpha.
+func toLinearSRGB(c color.Color) (r, g, b, a float64) {
+	if l, ok := c.(linearer); ok {
+		return l.linear()
+	}
+
+	r, g, b, a = unpremultiply(c.RGBA())
+
+	return linearize(r), linearize(g), linearize(b), a
+}
+
+// toSRGB returns the color as sRGB with straight alpha.
+func toSRGB(c color.Color) (r, g, b, a float64) {
+	if s, ok := c.(srgber); ok {
+		return s.srgb()
+	}
+
+	if l, ok := c.(linearer); ok {
+		r, g, b, a = l.linear()
+		return delinearize(r), delinearize(g), delinearize(b), a
+	}
+
+	return unpremultiply(c.RGBA())
+}
+
+// orZero returns v, or zero if v is NaN. The floating point color types hold a component that is missing, the CSS
+// keyword "none", as NaN, and it converts as zero.
+func orZero(v float64) float64 {
+	if math.IsNaN(v) {
+		return 0
+	}
+
+	return v
+}
+
+// clampPresent clamps x to [lo, hi] unless it is missing (NaN).
+func clampPresent(x, lo, hi float64) float64 {
+	if math.IsNaN(x) {
+		return x
+	}
+
+	return mathx.Clamp(x, lo, hi)
+}
+
+// unpremultiply converts alpha-premultiplied 16-bit channels to straight channels ∈ [0, 1].
+func unpremultiply(r, g, b, a uint32) (float64, float64, float64, float64) {
+	if a == 0 {
+		return 0, 0, 0, 0
+	}
+
+	alpha := float64(a)
+
+	return float64(r) / alpha, float64(g) / alpha, float64(b) / alpha, alpha / math.MaxUint16
+}
+
+// premultiply converts straight sRGB channels, clipped to [0, 1], to alpha-premultiplied 16-bit channels.
+func premultiply(r, g, b, a float64) (uint32, uint32, uint32, uint32) {
+	a = mathx.Clamp(a, 0, 1)
+
+	return uint32(math.Round(mathx.Clamp(r, 0, 1) * a * math.MaxUint16)),
+		uint32(math.Round(mathx.Clamp(g, 0, 1) * a * math.MaxUint16)),
+		uint32(math.Round(mathx.Clamp(b, 0, 1) * a * math.MaxUint16)),
+		uint32(math.Round(a * math.MaxUint16))
+}
+
+// premultiplyLinear converts linear-light sRGB with straight alpha to alpha-premultiplied 16-bit channels.
+func premultiplyLinear(r, g, b, a float64) (uint32, uint32, uint32, uint32) {
+	return premultiply(delinearize(r), delinearize(g), delinearize(b), a)
+}
+
+// linearize applies the inverse of the sRGB transfer function. It is extended to negative values by symmetry.
+func linearize(v float64) float64 {
+	abs := math.Abs(v)
+	if abs <= 0.04045 {
+		return v / 12.92
+	}
+
+	return math.Copysign(math.Pow((abs+0.055)/1.055, 2.4), v)
+}
+
+// linearizeRGB applies linearize to red, green and blue, and returns the alpha as it is.
+func linearizeRGB(r, g, b, a float64) (float64, float64, float64, float64) {
+	return linearize(r), linearize(g), linearize(b), a
+}
+
+// delinearize applies the sRGB transfer function. It is extended to negative values by symmetry.
+func delinearize(v float64) float64 {
+	abs := math.Abs(v)
+	if abs <= 0.0031308 {
+		return v * 12.92
+	}
+
+	return math.Copysign(1.055*math.Pow(abs, 1/2.4)-0.055, v)
+}
@@ -0,0 +1,122 @@
+package colorx
+
+import (
+	"image/color"
+	"testing"
+
+	"github.com/somebadcode/go-colorx/v2/internal/mathx"
+)
+
+func TestLinearize(t *testing.T) {
+	tests := []struct {
+		name string
+		v    float64
+		want float64
+	}{
+		{
+			name: "zero",
+			v:    0.0,
+			want: 0.0,
+		},
+		{
+			name: "toe",
+			v:    0.04,
+			want: 0.04 / 12.92,
+		},
+		{
+			name: "half",
+			v:    0.5,
+			want: 0.21404,
+		},
+		{
+			name: "one",
+			v:    1.0,
+			want: 1.0,
+		},
+		{
+			name: "negative",
+			v:    -0.5,
+			want: -0.21404,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := linearize(tt.v)
+			if !mathx.EqualP(got, tt.want, 1e-5) {
+				t.Errorf("linearize() = %v, want %v", got, tt.want)
+			}
+			if back := delinearize(got); !mathx.Equal(back, tt.v) {
+				t.Errorf("delinearize() = %v, want %v", back, tt.v)
+			}
+		})
+	}
+}
+
+func TestUnpremultiply(t *testing.T) {
+	gotR, gotG, gotB, gotA := unpremultiply(0x4000, 0x2000, 0, 0x8000)
+	if !mathx.EqualP(gotR, 0.5, 1e-4) || !mathx.EqualP(gotG, 0.25, 1e-4) || gotB != 0 {
+		t.Errorf("unpremultiply() = %v, %v, %v, want 0.5, 0.25, 0", gotR, gotG, gotB)
+	}
+	if !mathx.EqualP(gotA, 0.5, 1e-4) {
+		t.Errorf("unpremultiply() gotA = %v, want 0.5", gotA)
+	}
+
+	if r, g, b, a := unpremultiply(0, 0, 0, 0); r != 0 || g != 0 || b != 0 || a != 0 {
+		t.Errorf("unpremultiply() = %v, %v, %v, %v, want zero", r, g, b, a)
+	}
+}
+
+func TestSRGBModel(t *testing.T) {
+	tests := []struct {
+		name string
+		c    color.Color
+		want SRGB
+	}{
+		{
+			name: "nrgba",
+			c:    color.NRGBA{R: 0xFF, G: 0x80, A: 0x80},
+			want: SRGB{R: 1.0, G: 0x80 / 255.0, A: 0x80 / 255.0},
+		},
+		{
+			name: "out_of_gamut",
+			c:    LinearSRGB{R: 1.0, G: -0.25, B: 2.0, A: 1.0},
+			want: SRGB{R: 1.0, G: -delinearize(0.25), B: delinearize(2.0), A: 1.0},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := SRGBModel.Convert(tt.c).(SRGB)
+			if !mathx.EqualP(got.R, tt.want.R, 1e-4) || !mathx.EqualP(got.G, tt.want.G, 1e-4) ||
+				!mathx.EqualP(got.B, tt.want.B, 1e-4) || !mathx.EqualP(got.A, tt.want.A, 1e-4) {
+				t.Errorf("SRGBModel.Convert() = %+v, want %+v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestLinearSRGB_RGBA(t *testing.T) {
+	tests := []struct {
+		name string
+		c    LinearSRGB
+		want color.RGBA64
+	}{
+		{
+			name: "gray",
+			c:    LinearSRGB{R: 0.5, G: 0.5, B: 0.5, A: 1.0},
+			want: color.RGBA64{R: 0xBC40, G: 0xBC40, B: 0xBC40, A: 0xFFFF},
+		},
+		{
+			name: "clipped_translucent",
+			c:    LinearSRGB{R: 2.0, G: -1.0, A: 0.5},
+			want: color.RGBA64{R: 0x8000, A: 0x8000},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r, g, b, a := tt.c.RGBA()
+			if got := (color.RGBA64{R: uint16(r), G: uint16(g), B: uint16(b), A: uint16(a)}); got != tt.want {
+				t.Errorf("RGBA() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,72 @@
+package colorx
+
+import (
+	"image/color"
+
+	"github.com/somebadcode/go-colorx/v2/internal/mathx"
+)
+
+// WCAGReport is the result of checking a pair of colors against the WCAG 2.1 contrast requirements.
+type WCAGReport struct {
+	Ratio         float64 // Contrast ratio ∈ [1, 21]
+	NormalTextAA  bool    // Ratio ≥ 4.5, success criterion 1.4.3
+	NormalTextAAA bool    // Ratio ≥ 7, success criterion 1.4.6
+	LargeTextAA   bool    // Ratio ≥ 3, success criterion 1.4.3
+	LargeTextAAA  bool    // Ratio ≥ 4.5, success criterion 1.4.6
+	NonTextAA     bool    // Ratio ≥ 3, success criterion 1.4.11
+}
+
+// RelativeLuminance returns the relative luminance of the color as defined by WCAG 2.1, from 0 for black to 1 for
+// white. The color is clipped to the sRGB gamut and alpha is ignored.
+func RelativeLuminance(c color.Color) float64 {
+	r, g, b, _ := toSRGB(c)
+
+	return luminance(r, g, b)
+}
+
+// ContrastRatio returns the WCAG 2.1 contrast ratio of a foreground and a background color, from 1 for no contrast to
+// 21 for black on white. A translucent foreground is composited over the background first. The background is treated
+// as opaque.
+func ContrastRatio(fg, bg color.Color) float64 {
+	fgR, fgG, fgB, bgR, bgG, bgB := composite(fg, bg)
+
+	l1 := luminance(fgR, fgG, fgB)
+	l2 := luminance(bgR, bgG, bgB)
+	if l1 < l2 {
+		l1, l2 = l2, l1
+	}
+
+	return (l1 + 0.05) / (l2 + 0.05)
+}
+
+// CheckWCAG returns the contrast ratio of a foreground and a background color and whether it passes the WCAG 2.1 AA and
+// AAA levels for normal text, large text and user interface components. The ratio is not rounded before comparing.
+func CheckWCAG(fg, bg color.Color) WCAGReport {
+	ratio := ContrastRatio(fg, bg)
+
+	return WCAGReport{
+		Ratio:         ratio,
+		NormalTextAA:  ratio >= 4.5,
+		NormalTextAAA: ratio >= 7.0,
+		LargeTextAA:   ratio >= 3.0,
+		LargeTextAAA:  ratio >= 4.5,
+		NonTextAA:     ratio >= 3.0,
+	}
+}
+
+// luminance returns the relative luminance of sRGB channels clipped to [0, 1].
+func luminance(r, g, b float64) float64 {
+	return 0.2126*linearize(mathx.Clamp(r, 0, 1)) +
+		0.7152*linearize(mathx.Clamp(g, 0, 1)) +
+		0.0722*linearize(mathx.Clamp(b, 0, 1))
+}
+
+// composite returns the sRGB channels of the foreground blended over the opaque background, and of the background.
+func composite(fg, bg color.Color) (fgR, fgG, fgB, bgR, bgG, bgB float64) {
+	bgR, bgG, bgB, _ = toSRGB(bg)
+
+	r, g, b, a := toSRGB(fg)
+	a = mathx.Clamp(a, 0, 1)
+
+	return r*a + bgR*(1-a), g*a + bgG*(1-a), b*a + bgB*(1-a), bgR, bgG, bgB
+}
@@ -0,0 +1,158 @@
+package colorx
+
+import (
+	"image/color"
+	"testing"
+
+	"github.com/somebadcode/go-colorx/v2/internal/mathx"
+)
+
+func TestRelativeLuminance(t *testing.T) {
+	tests := []struct {
+		name string
+		c    color.Color
+		want float64
+	}{
+		{
+			name: "black",
+			c:    color.Black,
+			want: 0.0,
+		},
+		{
+			name: "white",
+			c:    color.White,
+			want: 1.0,
+		},
+		{
+			name: "gray",
+			c:    CSS{R: 0x80, G: 0x80, B: 0x80, Opacity: 1.0},
+			want: 0.21586,
+		},
+		{
+			name: "lime",
+			c:    HSLA{H: 120.0, S: 1.0, L: 0.5, A: 1.0},
+			want: 0.7152,
+		},
+		{
+			name: "out_of_gamut",
+			c:    OKLab{L: 1.2, Alpha: 1.0},
+			want: 1.0,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := RelativeLuminance(tt.c); !mathx.EqualP(got, tt.want, 1e-4) {
+				t.Errorf("RelativeLuminance() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestContrastRatio(t *testing.T) {
+	type args struct {
+		fg color.Color
+		bg color.Color
+	}
+	tests := []struct {
+		name string
+		args args
+		want float64
+	}{
+		{
+			name: "black_on_white",
+			args: args{fg: color.Black, bg: color.White},
+			want: 21.0,
+		},
+		{
+			name: "white_on_black",
+			args: args{fg: color.White, bg: color.Black},
+			want: 21.0,
+		},
+		{
+			name: "same",
+			args: args{fg: color.White, bg: color.White},
+			want: 1.0,
+		},
+		{
+			name: "gray_on_white",
+			args: args{fg: CSS{R: 0x76, G: 0x76, B: 0x76, Opacity: 1.0}, bg: color.White},
+			want: 4.5415,
+		},
+		{
+			name: "translucent_black_on_white",
+			args: args{fg: color.NRGBA{A: 0x80}, bg: color.White},
+			want: 4.0041,
+		},
+		{
+			name: "translucent_css_on_white",
+			args: args{fg: CSS{Opacity: 0.5}, bg: CSS{R: 0xFF, G: 0xFF, B: 0xFF, Opacity: 1.0}},
+			want: 3.9767,
+		},
+		{
+			name: "transparent",
+			args: args{fg: color.Transparent, bg: color.White},
+			want: 1.0,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := ContrastRatio(tt.args.fg, tt.args.bg); !mathx.EqualP(got, tt.want, 1e-3) {
+				t.Errorf("ContrastRatio() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestCheckWCAG(t *testing.T) {
+	type args struct {
+		fg color.Color
+		bg color.Color
+	}
+	tests := []struct {
+		name string
+		args args
+		want WCAGReport
+	}{
+		{
+			name: "black_on_white",
+			args: args{fg: color.Black, bg: color.White},
+			want: WCAGReport{
+				Ratio:         21.0,
+				NormalTextAA:  true,
+				NormalTextAAA: true,
+				LargeTextAA:   true,
+				LargeTextAAA:  true,
+				NonTextAA:     true,
+			},
+		},
+		{
+			name: "large_text_only",
+			args: args{fg: CSS{R: 0x77, G: 0x77, B: 0x77, Opacity: 1.0}, bg: color.White},
+			want: WCAGReport{
+				Ratio:       4.4781,
+				LargeTextAA: true,
+				NonTextAA:   true,
+			},
+		},
+		{
+			name: "fail",
+			args: args{fg: CSS{R: 0xBB, G: 0xBB, B: 0xBB, Opacity: 1.0}, bg: color.White},
+			want: WCAGReport{
+				Ratio: 1.9196,
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := CheckWCAG(tt.args.fg, tt.args.bg)
+			if !mathx.EqualP(got.Ratio, tt.want.Ratio, 1e-3) {
+				t.Errorf("CheckWCAG() Ratio = %v, want %v", got.Ratio, tt.want.Ratio)
+			}
+
+			got.Ratio = tt.want.Ratio
+			if got != tt.want {
+				t.Errorf("CheckWCAG() = %+v, want %+v", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,141 @@
+package colorx
+
+import (
+	"image/color"
+	"math"
+
+	"github.com/somebadcode/go-colorx/v2/internal/mathx"
+)
+
+// WhitePoint is the reference white of a color model as CIE XYZ tristimulus values, normalized so that Y is 1.
+type WhitePoint struct {
+	X float64
+	Y float64
+	Z float64
+}
+
+var (
+	// D50 is the CIE standard illuminant D50 (horizon light), the reference white of ICC profiles and of the CSS lab()
+	// and lch() functions.
+	D50 = WhitePoint{X: 0.3457 / 0.3585, Y: 1.0, Z: (1.0 - 0.3457 - 0.3585) / 0.3585}
+
+	// D65 is the CIE standard illuminant D65 (noon daylight), the reference white of sRGB.
+	D65 = WhitePoint{X: 0.3127 / 0.3290, Y: 1.0, Z: (1.0 - 0.3127 - 0.3290) / 0.3290}
+)
+
+// bradford is the cone response matrix of the Bradford chromatic adaptation transform.
+var bradford = mathx.Matrix3{
+	{0.8951, 0.2664, -0.1614},
+	{-0.7502, 1.7135, 0.0367},
+	{0.0389, -0.0685, 1.0296},
+}
+
+var bradfordInverse = bradford.Inverse()
+
+// XYZ is an implementation of the CIE 1931 XYZ color model. XYZ is device independent and is the basis of the other
+// CIE color models.
+type XYZ struct {
+	X     float64    // X ∈ [0, White.X] for colors in the sRGB gamut
+	Y     float64    // Luminance ∈ [0, 1]
+	Z     float64    // Z ∈ [0, White.Z] for colors in the sRGB gamut
+	A     float64    // Alpha ∈ [0, 1]
+	White WhitePoint // Reference white, D65 if zero
+}
+
+// XYZModel can convert the color to the XYZ color model defined in this package, relative to D65.
+var XYZModel = color.ModelFunc(xyzModel)
+
+func xyzModel(c color.Color) color.Color {
+	return convertXYZ(c, D65)
+}
+
+// NewXYZModel returns a model that can convert the color to the XYZ color model defined in this package, relative to
+// the reference white.
+func NewXYZModel(white WhitePoint) color.Model {
+	return color.ModelFunc(func(c color.Color) color.Color {
+		return convertXYZ(c, white)
+	})
+}
+
+func convertXYZ(c color.Color, white WhitePoint) XYZ {
+	if xyz, ok := c.(XYZ); ok && xyz.White.orD65() == white {
+		return xyz
+	}
+
+	r, g, b, a := toLinearSRGB(c)
+
+	return xyzFromLinear(r, g, b, a, white)
+}
+
+// RGBAToXYZ converts RGBA to X, Y, Z and Alpha relative to D65.
+func RGBAToXYZ(r, g, b, a uint8) (float64, float64, float64, float64) {
+	xyz := xyzFromLinear(linearize(float64(r)/math.MaxUint8), linearize(float64(g)/math.MaxUint8),
+		linearize(float64(b)/math.MaxUint8), float64(a)/math.MaxUint8, D65)
+
+	return xyz.X, xyz.Y, xyz.Z, xyz.A
+}
+
+// RGBA returns the alpha-premultiplied red, green, blue and alpha values for the color.
+func (xyz XYZ) RGBA() (r, g, b, a uint32) {
+	return premultiplyLinear(xyz.linear())
+}
+
+func (xyz XYZ) linear() (r, g, b, a float64) {
+	rgb := xyzToLinearSRGB.MulVec(adapt(mathx.Vector3{orZero(xyz.X), orZero(xyz.Y), orZero(xyz.Z)}, xyz.White.orD65(),
+		D65))
+
+	return rgb[0], rgb[1], rgb[2], orZero(xyz.A)
+}
+
+// String returns the color in the CSS color() function, such as "color(xyz-d65 0.2 0.3 0.4 / 0.8)". CSS has XYZ
+// relative to D50 and D65, so a color relative to another reference white is converted to D65 first.
+func (xyz XYZ) String() string {
+	switch xyz.White.orD65() {
+	case D50:
+		return functionString("color(xyz-d50 ", xyz.X, xyz.Y, xyz.Z, xyz.A)
+
+	case D65:
+		return functionString("color(xyz-d65 ", xyz.X, xyz.Y, xyz.Z, xyz.A)
+
+	default:
+		return convertXYZ(xyz, D65).String()
+	}
+}
+
+// xyzFromLinear converts linear-light sRGB to XYZ relative to the reference white.
+func xyzFromLinear(r, g, b, a float64, white WhitePoint) XYZ {
+	v := adapt(linearSRGBToXYZ.MulVec(mathx.Vector3{r, g, b}), D65, white)
+
+	return XYZ{X: v[0], Y: v[1], Z: v[2], A: a, White: white}
+}
+
+// orD65 returns the white point, or D65 if it is the zero value.
+func (w WhitePoint) orD65() WhitePoint {
+	if w == (WhitePoint{}) {
+		return D65
+	}
+
+	return w
+}
+
+func (w WhitePoint) vector() mathx.Vector3 {
+	return mathx.Vector3{w.X, w.Y, w.Z}
+}
+
+// adapt converts XYZ relative to one reference white to be relative to another using the Bradford transform.
+func adapt(v mathx.Vector3, from, to WhitePoint) mathx.Vector3 {
+	if from == to {
+		return v
+	}
+
+	return adaptation(from, to).MulVec(v)
+}
+
+// adaptation returns the Bradford transform that converts XYZ relative to one reference white to another.
+func adaptation(from, to WhitePoint) mathx.Matrix3 {
+	src := bradford.MulVec(from.vector())
+	dst := bradford.MulVec(to.vector())
+	scale := mathx.Diagonal(mathx.Vector3{dst[0] / src[0], dst[1] / src[1], dst[2] / src[2]})
+
+	return bradfordInverse.Mul(scale).Mul(bradford)
+}
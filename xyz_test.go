@@ -0,0 +1,144 @@
+package colorx
+
+import (
+	"image/color"
+	"testing"
+
+	"github.com/somebadcode/go-colorx/v2/internal/mathx"
+)
+
+func TestXYZModel(t *testing.T) {
+	type args struct {
+		c color.Color
+	}
+	tests := []struct {
+		name string
+		args args
+		want XYZ
+	}{
+		{
+			name: "white",
+			args: args{c: color.White},
+			want: XYZ{X: 0.95046, Y: 1.0, Z: 1.08906, A: 1.0},
+		},
+		{
+			name: "red",
+			args: args{c: color.RGBA{R: 0xFF, A: 0xFF}},
+			want: XYZ{X: 0.41239, Y: 0.21264, Z: 0.01933, A: 1.0},
+		},
+		{
+			name: "xyz",
+			args: args{c: XYZ{X: 0.5, Y: 0.5, Z: 0.5, A: 0.5}},
+			want: XYZ{X: 0.5, Y: 0.5, Z: 0.5, A: 0.5},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, ok := XYZModel.Convert(tt.args.c).(XYZ)
+			if !ok {
+				t.Fatalf("XYZModel.Convert() got = %T, want %T", got, tt.want)
+			}
+
+			if !mathx.EqualP(got.X, tt.want.X, 1e-4) {
+				t.Errorf("XYZModel.Convert() got X = %f, want %f", got.X, tt.want.X)
+			}
+			if !mathx.EqualP(got.Y, tt.want.Y, 1e-4) {
+				t.Errorf("XYZModel.Convert() got Y = %f, want %f", got.Y, tt.want.Y)
+			}
+			if !mathx.EqualP(got.Z, tt.want.Z, 1e-4) {
+				t.Errorf("XYZModel.Convert() got Z = %f, want %f", got.Z, tt.want.Z)
+			}
+			if !mathx.EqualP(got.A, tt.want.A, 1e-4) {
+				t.Errorf("XYZModel.Convert() got A = %f, want %f", got.A, tt.want.A)
+			}
+		})
+	}
+}
+
+func TestNewXYZModel(t *testing.T) {
+	got, ok := NewXYZModel(D50).Convert(color.White).(XYZ)
+	if !ok {
+		t.Fatalf("NewXYZModel().Convert() got = %T, want %T", got, XYZ{})
+	}
+
+	if !mathx.EqualP(got.X, D50.X, 1e-4) || !mathx.EqualP(got.Y, D50.Y, 1e-4) || !mathx.EqualP(got.Z, D50.Z, 1e-4) {
+		t.Errorf("NewXYZModel().Convert() got = %v, want %v", got, D50)
+	}
+	if got.White != D50 {
+		t.Errorf("NewXYZModel().Convert() got White = %v, want %v", got.White, D50)
+	}
+}
+
+func TestXYZ_RGBA(t *testing.T) {
+	tests := []struct {
+		name  string
+		xyz   XYZ
+		wantR uint32
+		wantG uint32
+		wantB uint32
+		wantA uint32
+	}{
+		{
+			name:  "black",
+			xyz:   XYZ{A: 1.0},
+			wantA: 0xFFFF,
+		},
+		{
+			name:  "white",
+			xyz:   XYZ{X: D65.X, Y: D65.Y, Z: D65.Z, A: 1.0},
+			wantR: 0xFFFF,
+			wantG: 0xFFFF,
+			wantB: 0xFFFF,
+			wantA: 0xFFFF,
+		},
+		{
+			name:  "white_d50",
+			xyz:   XYZ{X: D50.X, Y: D50.Y, Z: D50.Z, A: 1.0, White: D50},
+			wantR: 0xFFFF,
+			wantG: 0xFFFF,
+			wantB: 0xFFFF,
+			wantA: 0xFFFF,
+		},
+		{
+			name:  "white_half",
+			xyz:   XYZ{X: D65.X, Y: D65.Y, Z: D65.Z, A: 0.5},
+			wantR: 0x7FFF,
+			wantG: 0x7FFF,
+			wantB: 0x7FFF,
+			wantA: 0x8000,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			gotR, gotG, gotB, gotA := tt.xyz.RGBA()
+			if !mathx.EqualP(float64(gotR), float64(tt.wantR), 1) {
+				t.Errorf("RGBA() gotR = %v, want %v", gotR, tt.wantR)
+			}
+			if !mathx.EqualP(float64(gotG), float64(tt.wantG), 1) {
+				t.Errorf("RGBA() gotG = %v, want %v", gotG, tt.wantG)
+			}
+			if !mathx.EqualP(float64(gotB), float64(tt.wantB), 1) {
+				t.Errorf("RGBA() gotB = %v, want %v", gotB, tt.wantB)
+			}
+			if gotA != tt.wantA {
+				t.Errorf("RGBA() gotA = %v, want %v", gotA, tt.wantA)
+			}
+		})
+	}
+}
+
+func TestRGBAToXYZ(t *testing.T) {
+	gotX, gotY, gotZ, gotA := RGBAToXYZ(0x00, 0xFF, 0x00, 0xFF)
+	if !mathx.EqualP(gotX, 0.35758, 1e-4) {
+		t.Errorf("RGBAToXYZ() got X = %f, want %f", gotX, 0.35758)
+	}
+	if !mathx.EqualP(gotY, 0.71517, 1e-4) {
+		t.Errorf("RGBAToXYZ() got Y = %f, want %f", gotY, 0.71517)
+	}
+	if !mathx.EqualP(gotZ, 0.11919, 1e-4) {
+		t.Errorf("RGBAToXYZ() got Z = %f, want %f", gotZ, 0.11919)
+	}
+	if gotA != 1.0 {
+		t.Errorf("RGBAToXYZ() got A = %f, want %f", gotA, 1.0)
+	}
+}